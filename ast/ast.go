@@ -3,6 +3,7 @@ package ast
 import (
 	"bytes"
 	"fmt"
+	"math/big"
 	"sort"
 	"strings"
 
@@ -14,8 +15,26 @@ type Node interface {
 	// 디버깅, 테스트 용도로만 사용함
 	TokenLiteral() string
 	String() string
+	// 노드가 소스 코드에서 시작하는 위치
+	Pos() token.Position
+	// 노드가 소스 코드에서 끝나는 위치 바로 다음
+	End() token.Position
 }
 
+// Span 은 노드가 소스 코드에서 차지하는 [Start, Stop) 범위이며
+// 각 노드에 임베딩해 Node 인터페이스의 Pos, End 메서드를 제공함
+type Span struct {
+	Start token.Position
+	Stop  token.Position
+}
+
+func (s Span) Pos() token.Position { return s.Start }
+
+func (s Span) End() token.Position { return s.Stop }
+
+// SetSpan 메서드는 괄호로 감싼 표현식처럼 노드가 원래보다 넓은 소스 코드를 덮어야 할 때 범위를 바꿈
+func (s *Span) SetSpan(span Span) { *s = span }
+
 // Statement 인터페이스는 5, return 5; 같은 명령문을 의미함
 type Statement interface {
 	Node
@@ -42,6 +61,20 @@ func (p *Program) TokenLiteral() string {
 	return p.Statements[0].TokenLiteral()
 }
 
+func (p *Program) Pos() token.Position {
+	if len(p.Statements) == 0 {
+		return token.Position{}
+	}
+	return p.Statements[0].Pos()
+}
+
+func (p *Program) End() token.Position {
+	if len(p.Statements) == 0 {
+		return token.Position{}
+	}
+	return p.Statements[len(p.Statements)-1].End()
+}
+
 func (p *Program) String() string {
 	var out bytes.Buffer
 	for _, stmt := range p.Statements {
@@ -52,6 +85,7 @@ func (p *Program) String() string {
 
 // let <identifier> = <expression>;
 type LetStatement struct {
+	Span
 	Token token.Token // token.LET 토큰
 	Name  *Identifier
 	Value Expression
@@ -67,6 +101,7 @@ func (s *LetStatement) String() string {
 
 // return <expression>;
 type ReturnStatement struct {
+	Span
 	Token token.Token // token.RETURN 토큰
 	Value Expression
 }
@@ -82,6 +117,7 @@ func (s *ReturnStatement) String() string {
 // <expression>;
 // "x + 10;"처럼 표현식 하나로만 구성되는 명령문
 type ExpressionStatement struct {
+	Span
 	Token      token.Token // 표현식의 첫 번째 토큰
 	Expression Expression
 }
@@ -93,6 +129,7 @@ func (s *ExpressionStatement) TokenLiteral() string { return s.Token.Literal }
 func (s *ExpressionStatement) String() string { return s.Expression.String() }
 
 type BlockStatement struct {
+	Span
 	Token      token.Token // token.LBRACE 토큰
 	Statements []Statement
 }
@@ -109,7 +146,65 @@ func (s *BlockStatement) String() string {
 	return out.String()
 }
 
+// while (<condition>) <body>
+type WhileStatement struct {
+	Span
+	Token     token.Token // token.WHILE 토큰
+	Condition Expression
+	Body      *BlockStatement
+}
+
+func (s *WhileStatement) statementNode() {}
+
+func (s *WhileStatement) TokenLiteral() string { return s.Token.Literal }
+
+func (s *WhileStatement) String() string {
+	return fmt.Sprintf("while %s %s", s.Condition, s.Body)
+}
+
+// for (<variable> in <iterable>) <body>
+type ForStatement struct {
+	Span
+	Token    token.Token // token.FOR 토큰
+	Variable *Identifier
+	Iterable Expression
+	Body     *BlockStatement
+}
+
+func (s *ForStatement) statementNode() {}
+
+func (s *ForStatement) TokenLiteral() string { return s.Token.Literal }
+
+func (s *ForStatement) String() string {
+	return fmt.Sprintf("for %s in %s %s", s.Variable, s.Iterable, s.Body)
+}
+
+// break;
+type BreakStatement struct {
+	Span
+	Token token.Token // token.BREAK 토큰
+}
+
+func (s *BreakStatement) statementNode() {}
+
+func (s *BreakStatement) TokenLiteral() string { return s.Token.Literal }
+
+func (s *BreakStatement) String() string { return s.TokenLiteral() + ";" }
+
+// continue;
+type ContinueStatement struct {
+	Span
+	Token token.Token // token.CONTINUE 토큰
+}
+
+func (s *ContinueStatement) statementNode() {}
+
+func (s *ContinueStatement) TokenLiteral() string { return s.Token.Literal }
+
+func (s *ContinueStatement) String() string { return s.TokenLiteral() + ";" }
+
 type Identifier struct {
+	Span
 	Token token.Token // token.IDENTIFIER 토큰
 	Value string
 }
@@ -121,6 +216,7 @@ func (i *Identifier) TokenLiteral() string { return i.Token.Literal }
 func (i *Identifier) String() string { return i.Value }
 
 type IntegerLiteral struct {
+	Span
 	Token token.Token // token.INTEGER 토큰
 	Value int64
 }
@@ -131,7 +227,33 @@ func (l *IntegerLiteral) TokenLiteral() string { return l.Token.Literal }
 
 func (l *IntegerLiteral) String() string { return l.Token.Literal }
 
+// BigIntegerLiteral 은 int64 범위를 넘는 정수 리터럴
+type BigIntegerLiteral struct {
+	Span
+	Token token.Token // token.INTEGER 토큰
+	Value *big.Int
+}
+
+func (l *BigIntegerLiteral) expressionNode() {}
+
+func (l *BigIntegerLiteral) TokenLiteral() string { return l.Token.Literal }
+
+func (l *BigIntegerLiteral) String() string { return l.Token.Literal }
+
+type FloatLiteral struct {
+	Span
+	Token token.Token // token.FLOAT 토큰
+	Value float64
+}
+
+func (l *FloatLiteral) expressionNode() {}
+
+func (l *FloatLiteral) TokenLiteral() string { return l.Token.Literal }
+
+func (l *FloatLiteral) String() string { return l.Token.Literal }
+
 type StringLiteral struct {
+	Span
 	Token token.Token // token.STRING 토큰
 	Value string
 }
@@ -142,8 +264,33 @@ func (l *StringLiteral) TokenLiteral() string { return l.Token.Literal }
 
 func (l *StringLiteral) String() string { return l.Token.Literal }
 
+// "<string>${<expression>}<string>..."
+// 문자열 조각 사이사이에 표현식이 오므로 len(Strings) == len(Exprs) + 1 을 만족함
+type InterpolatedString struct {
+	Span
+	Token   token.Token // token.INTERPHEAD 토큰
+	Strings []string
+	Exprs   []Expression
+}
+
+func (l *InterpolatedString) expressionNode() {}
+
+func (l *InterpolatedString) TokenLiteral() string { return l.Token.Literal }
+
+func (l *InterpolatedString) String() string {
+	var out bytes.Buffer
+	for i, s := range l.Strings {
+		_, _ = out.WriteString(s)
+		if i < len(l.Exprs) {
+			_, _ = fmt.Fprintf(&out, "${%s}", l.Exprs[i])
+		}
+	}
+	return out.String()
+}
+
 // [<comma separated expressions>]
 type ArrayLiteral struct {
+	Span
 	Token    token.Token // token.LBRACKET 토큰
 	Elements []Expression
 }
@@ -163,6 +310,7 @@ func (l *ArrayLiteral) String() string {
 
 // {<expression>: <expression>}
 type HashLiteral struct {
+	Span
 	Token token.Token // token.LBRACE 토큰
 	Pairs map[Expression]Expression
 }
@@ -182,6 +330,7 @@ func (l *HashLiteral) String() string {
 
 // <prefix operator><expression>
 type PrefixExpression struct {
+	Span
 	Token    token.Token // 전위 연산자 토큰 (e.g. -, !)
 	Operator string
 	Right    Expression
@@ -197,6 +346,7 @@ func (exp *PrefixExpression) String() string {
 
 // <expression> <infix operator> <expression>
 type InfixExpression struct {
+	Span
 	Token    token.Token // 중위 연산자 토큰 (e.g. +, -)
 	Operator string
 	Left     Expression
@@ -211,7 +361,26 @@ func (exp *InfixExpression) String() string {
 	return fmt.Sprintf("(%s %s %s)", exp.Left, exp.Operator, exp.Right)
 }
 
+// <target> <operator> <value>
+// "x = 1", "x += 1"처럼 이미 정의된 이름에 값을 대입하는 표현식
+type AssignExpression struct {
+	Span
+	Token    token.Token // 대입 연산자 토큰 (e.g. =, +=)
+	Target   Expression
+	Operator string
+	Value    Expression
+}
+
+func (exp *AssignExpression) expressionNode() {}
+
+func (exp *AssignExpression) TokenLiteral() string { return exp.Token.Literal }
+
+func (exp *AssignExpression) String() string {
+	return fmt.Sprintf("(%s %s %s)", exp.Target, exp.Operator, exp.Value)
+}
+
 type Boolean struct {
+	Span
 	Token token.Token
 	Value bool
 }
@@ -224,6 +393,7 @@ func (b *Boolean) String() string { return b.Token.Literal }
 
 // if (<condition>) <consequence> else <alternative>
 type IfExpression struct {
+	Span
 	Token       token.Token // token.IF 토큰
 	Condition   Expression
 	Consequence *BlockStatement
@@ -244,9 +414,16 @@ func (exp *IfExpression) String() string {
 
 // fn <parameters> <block statement>
 type FunctionLiteral struct {
+	Span
 	Token  token.Token // token.FUNCTION 토큰
 	Params []*Identifier
-	Body   *BlockStatement
+	// 기본값이 있는 마지막 len(Defaults)개 파라미터의 기본값
+	Defaults []Expression
+	// ...rest 처럼 남은 인자를 배열로 받는 파라미터, 없다면 nil
+	Rest *Identifier
+	Body *BlockStatement
+	// let 문으로 바로 선언한 함수의 이름, 익명 함수라면 비어 있음
+	Name string
 }
 
 func (l *FunctionLiteral) expressionNode() {}
@@ -254,24 +431,36 @@ func (l *FunctionLiteral) expressionNode() {}
 func (l *FunctionLiteral) TokenLiteral() string { return l.Token.Literal }
 
 func (l *FunctionLiteral) String() string {
-	params := make([]string, len(l.Params))
-	for i, p := range l.Params {
-		params[i] = p.String()
-	}
-
 	return fmt.Sprintf(
 		"%s(%s) %s",
 		l.TokenLiteral(),
-		strings.Join(params, ", "),
+		FormatParams(l.Params, l.Defaults, l.Rest),
 		l.Body,
 	)
 }
 
+// FormatParams 함수는 파라미터 목록을 x, y = 10, ...rest 형태로 만듦
+func FormatParams(params []*Identifier, defaults []Expression, rest *Identifier) string {
+	out := make([]string, len(params))
+	required := len(params) - len(defaults)
+	for i, p := range params {
+		out[i] = p.String()
+		if i >= required {
+			out[i] += " = " + defaults[i-required].String()
+		}
+	}
+	if rest != nil {
+		out = append(out, "..."+rest.String())
+	}
+	return strings.Join(out, ", ")
+}
+
 // <expression>(<comma separated expressions>)
 type CallExpression struct {
-	Token     token.Token // token.LPAREN 토큰
-	Function  Expression  // 식별자(e.g. add(1, 2))거나 함수 리터럴(e.g. fn(x) { x; }(42))
-	Arguments []Expression
+	Span
+	Token     token.Token  // token.LPAREN 토큰
+	Function  Expression   // 식별자(e.g. add(1, 2))거나 함수 리터럴(e.g. fn(x) { x; }(42))
+	Arguments []Expression // 키워드 인자는 *KeywordArgument 로 위치 인자 뒤에 옴
 }
 
 func (exp *CallExpression) expressionNode() {}
@@ -291,8 +480,25 @@ func (exp *CallExpression) String() string {
 	)
 }
 
+// 함수 호출에서 <identifier>: <expression> 형태로 이름을 지정해 넘기는 인자
+type KeywordArgument struct {
+	Span
+	Token token.Token // token.IDENTIFIER 토큰
+	Name  *Identifier
+	Value Expression
+}
+
+func (a *KeywordArgument) expressionNode() {}
+
+func (a *KeywordArgument) TokenLiteral() string { return a.Token.Literal }
+
+func (a *KeywordArgument) String() string {
+	return fmt.Sprintf("%s: %s", a.Name, a.Value)
+}
+
 // <expression>[<expression>]
 type IndexExpression struct {
+	Span
 	Token token.Token // token.LBRACKET 토큰
 	Left  Expression
 	Index Expression
@@ -305,3 +511,28 @@ func (exp *IndexExpression) TokenLiteral() string { return exp.Token.Literal }
 func (exp *IndexExpression) String() string {
 	return fmt.Sprintf("(%s[%s])", exp.Left, exp.Index)
 }
+
+// <expression>[<expression>:<expression>]
+// 시작과 끝 인덱스는 생략할 수 있음
+type SliceExpression struct {
+	Span
+	Token token.Token // token.LBRACKET 토큰
+	Left  Expression
+	Low   Expression
+	High  Expression
+}
+
+func (exp *SliceExpression) expressionNode() {}
+
+func (exp *SliceExpression) TokenLiteral() string { return exp.Token.Literal }
+
+func (exp *SliceExpression) String() string {
+	var low, high string
+	if exp.Low != nil {
+		low = exp.Low.String()
+	}
+	if exp.High != nil {
+		high = exp.High.String()
+	}
+	return fmt.Sprintf("(%s[%s:%s])", exp.Left, low, high)
+}
@@ -1,6 +1,7 @@
 package ast
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -26,3 +27,34 @@ func TestProgram_String(t *testing.T) {
 	}
 	require.Equal(t, "let x = y;", p.String())
 }
+
+func TestFprint(t *testing.T) {
+	pos := func(column int) token.Position {
+		return token.Position{Offset: column - 1, Line: 1, Column: column}
+	}
+	p := &Program{
+		Statements: []Statement{
+			&ExpressionStatement{
+				Span: Span{Start: pos(1), Stop: pos(6)},
+				Expression: &InfixExpression{
+					Span:     Span{Start: pos(1), Stop: pos(6)},
+					Operator: "+",
+					Left:     &IntegerLiteral{Span: Span{Start: pos(1), Stop: pos(2)}, Value: 1},
+					Right: &ArrayLiteral{
+						Span:     Span{Start: pos(5), Stop: pos(6)},
+						Elements: []Expression{},
+					},
+				},
+			},
+		},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, Fprint(&out, p))
+	require.Equal(t, `*ast.Program 1:1-1:6
+  Statements[0]: *ast.ExpressionStatement 1:1-1:6
+    Expression: *ast.InfixExpression 1:1-1:6 Operator="+"
+      Left: *ast.IntegerLiteral 1:1-1:2 Value=1
+      Right: *ast.ArrayLiteral 1:5-1:6
+`, out.String())
+}
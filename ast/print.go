@@ -0,0 +1,110 @@
+package ast
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+var (
+	nodeType = reflect.TypeOf((*Node)(nil)).Elem()
+	spanType = reflect.TypeOf(Span{})
+)
+
+// Fprint 함수는 노드를 들여쓰기한 트리 형태로 출력함
+// 각 줄에는 노드의 타입과 범위, 자식 노드가 아닌 필드의 값이 나타남
+//
+//	*ast.ExpressionStatement 1:1-1:6
+//	  Expression: *ast.InfixExpression 1:1-1:6 Operator="+"
+//	    Left: *ast.IntegerLiteral 1:1-1:2 Value=1
+//	    Right: *ast.IntegerLiteral 1:5-1:6 Value=2
+func Fprint(w io.Writer, node Node) error {
+	var out bytes.Buffer
+	printNode(&out, "", reflect.ValueOf(node), 0)
+	_, err := w.Write(out.Bytes())
+	return err
+}
+
+func printNode(out *bytes.Buffer, label string, v reflect.Value, depth int) {
+	indent := strings.Repeat("  ", depth)
+	if label != "" {
+		label += ": "
+	}
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if !v.IsValid() || v.IsNil() {
+		_, _ = fmt.Fprintf(out, "%s%snil\n", indent, label)
+		return
+	}
+
+	node := v.Interface().(Node)
+	// 파일 이름은 모든 노드가 같으므로 행과 열만 출력함
+	start, end := node.Pos(), node.End()
+	_, _ = fmt.Fprintf(out, "%s%s%s %d:%d-%d:%d", indent, label, v.Type(), start.Line, start.Column, end.Line, end.Column)
+
+	// 자식 노드가 아닌 필드는 같은 줄에 출력하고 자식 노드는 다음 줄부터 들여써서 출력함
+	type child struct {
+		label string
+		value reflect.Value
+	}
+	var children []child
+	elem := v.Elem()
+	for i := 0; i < elem.NumField(); i++ {
+		field := elem.Type().Field(i)
+		value := elem.Field(i)
+		// 범위는 이미 출력했고 토큰은 다른 필드와 겹치므로 건너뜀
+		if field.Type == spanType || field.Name == "Token" {
+			continue
+		}
+
+		switch {
+		case field.Type.Implements(nodeType):
+			if value.IsNil() {
+				continue
+			}
+			children = append(children, child{label: field.Name, value: value})
+		case field.Type.Kind() == reflect.Slice && field.Type.Elem().Implements(nodeType):
+			for j := 0; j < value.Len(); j++ {
+				children = append(children, child{label: fmt.Sprintf("%s[%d]", field.Name, j), value: value.Index(j)})
+			}
+		case field.Type.Kind() == reflect.Map && field.Type.Key().Implements(nodeType):
+			// 맵은 순서가 없으므로 소스 코드에 나타난 순서대로 출력함
+			keys := value.MapKeys()
+			sort.Slice(keys, func(a, b int) bool {
+				return keys[a].Interface().(Node).Pos().Offset < keys[b].Interface().(Node).Pos().Offset
+			})
+			for j, key := range keys {
+				children = append(children,
+					child{label: fmt.Sprintf("%s[%d].Key", field.Name, j), value: key},
+					child{label: fmt.Sprintf("%s[%d].Value", field.Name, j), value: value.MapIndex(key)},
+				)
+			}
+		default:
+			_, _ = fmt.Fprintf(out, " %s=%s", field.Name, formatValue(value))
+		}
+	}
+	_ = out.WriteByte('\n')
+
+	for _, c := range children {
+		printNode(out, c.label, c.value, depth+1)
+	}
+}
+
+func formatValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return fmt.Sprintf("%q", v.String())
+	case reflect.Slice:
+		elems := make([]string, v.Len())
+		for i := range elems {
+			elems[i] = formatValue(v.Index(i))
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	default:
+		return fmt.Sprintf("%v", v.Interface())
+	}
+}
@@ -0,0 +1,128 @@
+package diagnostic
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+
+	"go-interpreter/token"
+)
+
+type Severity string
+
+const (
+	Error   Severity = "error"
+	Warning Severity = "warning"
+)
+
+// Code 는 진단의 종류를 나타내며 에디터 연동 등에서 진단을 구분하는 데 사용함
+type Code string
+
+const (
+	// 렉싱
+	IllegalCharacter    Code = "illegal-character"
+	UnterminatedString  Code = "unterminated-string"
+	InvalidEscape       Code = "invalid-escape"
+	UnterminatedComment Code = "unterminated-comment"
+	// 파싱
+	UnexpectedToken Code = "unexpected-token"
+	InvalidNumber   Code = "invalid-number"
+	// 반복문 밖의 break, continue처럼 쓸 수 없는 곳에 쓴 명령문
+	MisplacedStatement Code = "misplaced-statement"
+	// 대입할 수 없는 대상에 값을 대입함
+	InvalidAssignment Code = "invalid-assignment"
+	// 키워드 인자 뒤의 위치 인자처럼 잘못된 함수 호출 인자
+	InvalidArgument Code = "invalid-argument"
+	// 평가
+	RuntimeError Code = "runtime-error"
+	// 그 외
+	ReadError Code = "read-error"
+)
+
+// Span 은 진단이 가리키는 소스 코드의 [Start, End) 범위
+type Span struct {
+	Start token.Position `json:"start"`
+	End   token.Position `json:"end"`
+}
+
+// Diagnostic 은 렉싱, 파싱, 평가 과정에서 발생한 문제를 나타냄
+// error 인터페이스를 구현하므로 multierror.Error에 그대로 담을 수 있음
+type Diagnostic struct {
+	Severity Severity `json:"severity"`
+	Code     Code     `json:"code"`
+	Span     Span     `json:"span"`
+	Message  string   `json:"message"`
+	// 문제를 해결하는 데 도움이 되는 부가 설명
+	Notes []string `json:"notes,omitempty"`
+}
+
+// Errorf 함수는 주어진 범위를 가리키는 에러 진단을 생성함
+func Errorf(code Code, start, end token.Position, format string, args ...any) *Diagnostic {
+	return &Diagnostic{
+		Severity: Error,
+		Code:     code,
+		Span: Span{
+			Start: start,
+			End:   end,
+		},
+		Message: fmt.Sprintf(format, args...),
+		Notes:   nil,
+	}
+}
+
+// WithNote 메서드는 부가 설명을 덧붙인 진단을 반환함
+func (d *Diagnostic) WithNote(format string, args ...any) *Diagnostic {
+	d.Notes = append(d.Notes, fmt.Sprintf(format, args...))
+	return d
+}
+
+func (d *Diagnostic) Error() string {
+	return fmt.Sprintf("%s: %s", d.Span.Start, d.Message)
+}
+
+// From 함수는 multierror.Error 등에 담긴 에러를 진단 목록으로 펼침
+// 진단이 아닌 에러는 위치가 없는 진단으로 감쌈
+func From(err error) []*Diagnostic {
+	if err == nil {
+		return nil
+	}
+
+	errs := []error{err}
+	var merr *multierror.Error
+	if errors.As(err, &merr) {
+		errs = merr.WrappedErrors()
+	}
+
+	diags := make([]*Diagnostic, 0, len(errs))
+	for _, err := range errs {
+		var d *Diagnostic
+		if errors.As(err, &d) {
+			diags = append(diags, d)
+			continue
+		}
+		diags = append(diags, &Diagnostic{
+			Severity: Error,
+			Code:     ReadError,
+			Message:  err.Error(),
+		})
+	}
+	return diags
+}
+
+// Sort 함수는 에러를 소스 코드에서 나타난 순서대로 정렬함
+// 위치가 없는 에러는 맨 뒤로 보냄
+func Sort(errs []error) {
+	offset := func(err error) int {
+		var d *Diagnostic
+		if errors.As(err, &d) && d.Span.Start.IsValid() {
+			return d.Span.Start.Offset
+		}
+		return math.MaxInt
+	}
+	sort.SliceStable(errs, func(i, j int) bool {
+		return offset(errs[i]) < offset(errs[j])
+	})
+}
@@ -0,0 +1,147 @@
+package diagnostic
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-interpreter/token"
+)
+
+func pos(offset, line, column int) token.Position {
+	return token.Position{Offset: offset, Line: line, Column: column}
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		diag     *Diagnostic
+		src      string
+		expected string
+	}{
+		{
+			name: "single character",
+			diag: Errorf(IllegalCharacter, pos(8, 1, 9), pos(9, 1, 10), "illegal character '@'"),
+			src:  "let x = @;",
+			expected: `error[illegal-character]: illegal character '@'
+ --> 1:9
+  |
+1 | let x = @;
+  |         ^
+`,
+		},
+		{
+			name: "span with note",
+			diag: Errorf(UnterminatedString, pos(11, 2, 9), pos(15, 2, 13), "unterminated string literal").
+				WithNote("use a raw string literal"),
+			src: "let x = 1;\nlet y = \"abc",
+			expected: `error[unterminated-string]: unterminated string literal
+ --> 2:9
+  |
+2 | let y = "abc
+  |         ^^^^
+  = note: use a raw string literal
+`,
+		},
+		{
+			name:     "tab indentation",
+			diag:     Errorf(UnexpectedToken, pos(2, 1, 3), pos(3, 1, 4), "unexpected"),
+			src:      "\t\t;",
+			expected: "error[unexpected-token]: unexpected\n --> 1:3\n  |\n1 | \t\t;\n  | \t\t^\n",
+		},
+		{
+			name: "hangul",
+			diag: Errorf(IllegalCharacter, pos(13, 1, 10), pos(14, 1, 11), "illegal character '@'"),
+			src:  "let 이름 = @;",
+			expected: `error[illegal-character]: illegal character '@'
+ --> 1:10
+  |
+1 | let 이름 = @;
+  |            ^
+`,
+		},
+		{
+			name: "hangul span",
+			diag: Errorf(RuntimeError, pos(0, 1, 1), pos(12, 1, 9), "unsupported operator: 'string' + 'int'"),
+			src:  `"가나" + 1`,
+			expected: `error[runtime-error]: unsupported operator: 'string' + 'int'
+ --> 1:1
+  |
+1 | "가나" + 1
+  | ^^^^^^^^^^
+`,
+		},
+		{
+			name: "multiple lines",
+			diag: Errorf(UnexpectedToken, pos(4, 1, 5), pos(12, 2, 3), "unexpected"),
+			src:  "let abc\n= 1",
+			expected: `error[unexpected-token]: unexpected
+ --> 1:5
+  |
+1 | let abc
+  |     ^^^
+`,
+		},
+		{
+			name: "without position",
+			diag: &Diagnostic{Severity: Error, Code: ReadError, Message: "failed to read input"},
+			src:  "1",
+			expected: `error[read-error]: failed to read input
+`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			require.NoError(t, Render(&out, tc.diag, tc.src))
+			assert.Equal(t, tc.expected, out.String())
+		})
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	d := Errorf(InvalidNumber, pos(0, 1, 1), pos(3, 1, 4), "could not parse %q as integer", "0b2").WithNote("note")
+	require.NoError(t, WriteJSON(&out, []*Diagnostic{d}))
+	assert.JSONEq(t, `[{
+		"severity": "error",
+		"code": "invalid-number",
+		"span": {
+			"start": {"offset": 0, "line": 1, "column": 1},
+			"end": {"offset": 3, "line": 1, "column": 4}
+		},
+		"message": "could not parse \"0b2\" as integer",
+		"notes": ["note"]
+	}]`, out.String())
+
+	out.Reset()
+	require.NoError(t, WriteJSON(&out, nil))
+	assert.JSONEq(t, `[]`, out.String())
+}
+
+func TestFromAndSort(t *testing.T) {
+	t.Parallel()
+
+	second := Errorf(UnexpectedToken, pos(10, 2, 1), pos(11, 2, 2), "second")
+	first := Errorf(IllegalCharacter, pos(0, 1, 1), pos(1, 1, 2), "first")
+	var errs *multierror.Error
+	errs = multierror.Append(errs, errors.New("read failed"), second, first)
+	Sort(errs.Errors)
+
+	diags := From(errs)
+	require.Len(t, diags, 3)
+	assert.Equal(t, first, diags[0])
+	assert.Equal(t, second, diags[1])
+	assert.Equal(t, ReadError, diags[2].Code)
+	assert.Equal(t, "read failed", diags[2].Message)
+	assert.Equal(t, "1:1: first", first.Error())
+	assert.Nil(t, From(nil))
+}
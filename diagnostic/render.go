@@ -0,0 +1,109 @@
+package diagnostic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// Render 함수는 진단을 사람이 읽기 좋은 형태로 출력함
+// src가 주어지면 문제가 발생한 줄과 그 아래에 범위를 가리키는 밑줄을 함께 보여줌
+//
+//	error[unexpected-token]: expected: ), but got: +
+//	 --> main.monkey:1:9
+//	  |
+//	1 | fn(x, y + z) {}
+//	  |         ^
+func Render(w io.Writer, d *Diagnostic, src string) error {
+	var out bytes.Buffer
+	_, _ = fmt.Fprintf(&out, "%s[%s]: %s\n", d.Severity, d.Code, d.Message)
+
+	gutter := " "
+	if d.Span.Start.IsValid() {
+		_, _ = fmt.Fprintf(&out, " --> %s\n", d.Span.Start)
+	}
+	if line, ok := sourceLine(src, d.Span.Start.Line); ok {
+		num := strconv.Itoa(d.Span.Start.Line)
+		gutter = strings.Repeat(" ", len(num))
+		_, _ = fmt.Fprintf(&out, "%s |\n", gutter)
+		_, _ = fmt.Fprintf(&out, "%s | %s\n", num, line)
+		_, _ = fmt.Fprintf(&out, "%s | %s\n", gutter, underline(line, d.Span))
+	}
+	for _, note := range d.Notes {
+		_, _ = fmt.Fprintf(&out, "%s = note: %s\n", gutter, note)
+	}
+
+	_, err := w.Write(out.Bytes())
+	return err
+}
+
+// WriteJSON 함수는 에디터 연동 등 기계가 읽을 수 있도록 진단 목록을 JSON 배열로 출력함
+func WriteJSON(w io.Writer, diags []*Diagnostic) error {
+	if diags == nil {
+		diags = []*Diagnostic{}
+	}
+	return json.NewEncoder(w).Encode(diags)
+}
+
+// 1부터 시작하는 n번째 줄을 반환함
+func sourceLine(src string, n int) (string, bool) {
+	if n < 1 {
+		return "", false
+	}
+	lines := strings.Split(src, "\n")
+	if n > len(lines) {
+		return "", false
+	}
+	return strings.TrimSuffix(lines[n-1], "\r"), true
+}
+
+// 범위의 시작 열부터 끝 열까지 ^ 로 밑줄을 그림
+// 여러 줄에 걸친 범위는 시작한 줄의 끝까지 밑줄을 그림
+// 한글처럼 터미널에서 두 칸을 차지하는 글자가 있어도 밑줄이 어긋나지 않도록 글자 수가 아닌 칸 수로 맞춤
+func underline(line string, span Span) string {
+	runes := []rune(line)
+	start := span.Start.Column - 1
+	if start < 0 {
+		start = 0
+	}
+	if start > len(runes) {
+		start = len(runes)
+	}
+
+	width := 1
+	switch {
+	case span.End.Line == span.Start.Line && span.End.Column > span.Start.Column:
+		width = span.End.Column - span.Start.Column
+	case span.End.Line > span.Start.Line && len(runes) > start:
+		width = len(runes) - start
+	}
+
+	var sb strings.Builder
+	// 탭은 그대로 남겨 밑줄이 원래 줄과 같은 위치에 오도록 함
+	for _, r := range runes[:start] {
+		if r == '\t' {
+			_ = sb.WriteByte('\t')
+		} else {
+			_, _ = sb.WriteString(strings.Repeat(" ", runewidth.RuneWidth(r)))
+		}
+	}
+
+	// 줄의 끝을 넘어선 범위는 한 글자에 한 칸씩 셈
+	end := start + width
+	cells := 0
+	if end > len(runes) {
+		cells = end - len(runes)
+		end = len(runes)
+	}
+	cells += runewidth.StringWidth(string(runes[start:end]))
+	if cells < 1 {
+		cells = 1
+	}
+	_, _ = sb.WriteString(strings.Repeat("^", cells))
+	return sb.String()
+}
@@ -2,13 +2,19 @@ package evaluator
 
 import (
 	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"go-interpreter/object"
 )
 
 var builtins = map[string]*object.Builtin{
 	"len": {
+		Name: "len",
 		Fn: func(args ...object.Object) object.Object {
 			if len(args) != 1 {
 				return makeError("len() takes exactly one argument: %d given", len(args))
@@ -18,20 +24,138 @@ var builtins = map[string]*object.Builtin{
 			case *object.Array:
 				return &object.Integer{Value: int64(len(arg.Elements))}
 			case *object.String:
-				return &object.Integer{Value: int64(len(arg.Value))}
+				return &object.Integer{Value: int64(utf8.RuneCountInString(arg.Value))}
+			case *object.Range:
+				return object.NewInteger(new(big.Int).SetUint64(arg.Len()))
 			default:
 				return makeError("unsupported argument type of len(): '%s'", arg.Type())
 			}
 		},
 	},
-	"print": {
+	"float": {
+		Name: "float",
+		Fn: func(args ...object.Object) object.Object {
+			if len(args) != 1 {
+				return makeError("float() takes exactly one argument: %d given", len(args))
+			}
+
+			switch arg := args[0].(type) {
+			case *object.Float:
+				return arg
+			case *object.Integer:
+				return &object.Float{Value: float64(arg.Value)}
+			case *object.BigInteger:
+				f := toFloat(arg)
+				if math.IsInf(f, 0) {
+					return makeError("int too large to convert to float")
+				}
+				return &object.Float{Value: f}
+			case *object.String:
+				f, err := strconv.ParseFloat(strings.TrimSpace(arg.Value), 64)
+				if err != nil {
+					return makeError("invalid literal for float(): '%s'", arg.Value)
+				}
+				return &object.Float{Value: f}
+			default:
+				return makeError("unsupported argument type of float(): '%s'", arg.Type())
+			}
+		},
+	},
+	"int": {
+		Name: "int",
 		Fn: func(args ...object.Object) object.Object {
+			if len(args) != 1 {
+				return makeError("int() takes exactly one argument: %d given", len(args))
+			}
+
+			switch arg := args[0].(type) {
+			case *object.Integer, *object.BigInteger:
+				return arg
+			case *object.Float:
+				if math.IsNaN(arg.Value) || math.IsInf(arg.Value, 0) {
+					return makeError("cannot convert float %s to int", arg)
+				}
+				// 소수점 아래는 버림
+				i, _ := big.NewFloat(arg.Value).Int(nil)
+				return object.NewInteger(i)
+			case *object.String:
+				i, ok := new(big.Int).SetString(strings.TrimSpace(arg.Value), 10)
+				if !ok {
+					return makeError("invalid literal for int(): '%s'", arg.Value)
+				}
+				return object.NewInteger(i)
+			default:
+				return makeError("unsupported argument type of int(): '%s'", arg.Type())
+			}
+		},
+	},
+	"range": {
+		Name: "range",
+		Fn: func(args ...object.Object) object.Object {
+			if len(args) < 1 || len(args) > 3 {
+				return makeError("range() takes 1 to 3 arguments: %d given", len(args))
+			}
+
+			bounds := make([]int64, len(args))
+			for i, arg := range args {
+				if arg.Type() != object.IntegerObject {
+					return makeError("range() arguments must be int: '%s' given", arg.Type())
+				}
+				n, ok := arg.(*object.Integer)
+				if !ok {
+					return makeError("range() argument too large: %s", arg)
+				}
+				bounds[i] = n.Value
+			}
+
+			r := &object.Range{Start: 0, Stop: 0, Step: 1}
+			switch len(bounds) {
+			case 1:
+				r.Stop = bounds[0]
+			case 2:
+				r.Start, r.Stop = bounds[0], bounds[1]
+			case 3:
+				r.Start, r.Stop, r.Step = bounds[0], bounds[1], bounds[2]
+			}
+			if r.Step == 0 {
+				return makeError("range() step must not be zero")
+			}
+			return r
+		},
+	},
+	"print": {
+		Name: "print",
+		// sep 으로 인자 사이의 구분자를, end 로 마지막에 붙일 문자열을 바꿀 수 있음
+		KeywordFn: func(kwargs map[string]object.Object, args ...object.Object) object.Object {
+			opts := map[string]string{"sep": " ", "end": "\n"}
+			for _, name := range keywordNames(kwargs) {
+				v := kwargs[name]
+				if _, ok := opts[name]; !ok {
+					return makeError("print() got an unexpected keyword argument '%s'", name)
+				}
+				s, ok := v.(*object.String)
+				if !ok {
+					return makeError("print() argument '%s' must be string: '%s' given", name, v.Type())
+				}
+				opts[name] = s.Value
+			}
+
 			ss := make([]string, len(args))
 			for i, arg := range args {
 				ss[i] = arg.String()
 			}
-			_, _ = fmt.Println(strings.Join(ss, " "))
+			_, _ = fmt.Print(strings.Join(ss, opts["sep"]) + opts["end"])
 			return nil
 		},
 	},
 }
+
+// BuiltinNames 함수는 모든 내장 함수의 이름을 정렬해 반환함
+func BuiltinNames() []string {
+	names := make([]string, 0, len(builtins))
+	for name := range builtins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
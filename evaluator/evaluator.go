@@ -2,6 +2,11 @@ package evaluator
 
 import (
 	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+	"unicode/utf8"
 
 	"go-interpreter/ast"
 	"go-interpreter/object"
@@ -11,9 +16,22 @@ var (
 	Null  = &object.Null{}
 	True  = &object.Boolean{Value: true}
 	False = &object.Boolean{Value: false}
+
+	breakSignal    = &object.Break{}
+	continueSignal = &object.Continue{}
 )
 
+// Eval 함수는 노드를 평가하며 위치가 정해지지 않은 에러에 노드의 범위를 기록함
+// 가장 안쪽에서 에러를 만든 노드의 범위가 남음
 func Eval(node ast.Node, env *object.Environment) object.Object {
+	evaluated := eval(node, env)
+	if err, ok := evaluated.(*object.Error); ok && !err.Span.Start.IsValid() && node != nil {
+		err.Span = ast.Span{Start: node.Pos(), Stop: node.End()}
+	}
+	return evaluated
+}
+
+func eval(node ast.Node, env *object.Environment) object.Object {
 	switch node := node.(type) {
 	// 명령문
 	case *ast.Program:
@@ -34,6 +52,14 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 			return v
 		}
 		env.Set(node.Name.Value, v)
+	case *ast.WhileStatement:
+		return evalWhile(node, env)
+	case *ast.ForStatement:
+		return evalFor(node, env)
+	case *ast.BreakStatement:
+		return breakSignal
+	case *ast.ContinueStatement:
+		return continueSignal
 	// 표현식
 	case *ast.PrefixExpression:
 		right := Eval(node.Right, env)
@@ -46,12 +72,17 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 		if isError(left) {
 			return left
 		}
+		if node.Operator == "&&" || node.Operator == "||" {
+			return evalLogical(node, left, env)
+		}
 
 		right := Eval(node.Right, env)
 		if isError(right) {
 			return right
 		}
 		return evalInfix(node.Operator, left, right)
+	case *ast.AssignExpression:
+		return evalAssign(node, env)
 	case *ast.IndexExpression:
 		left := Eval(node.Left, env)
 		if isError(left) {
@@ -63,6 +94,8 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 			return index
 		}
 		return evalIndex(left, index)
+	case *ast.SliceExpression:
+		return evalSlice(node, env)
 	case *ast.IfExpression:
 		return evalIf(node, env)
 	case *ast.CallExpression:
@@ -70,18 +103,23 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 		if isError(fn) {
 			return fn
 		}
-		args := evalExpressions(node.Arguments, env)
-		// 인자 평가 도중 에러가 발생했다면 항상 에러만 반환됨
-		if len(args) == 1 && isError(args[0]) {
-			return args[0]
+		args, kwargs, errObj := evalArguments(node.Arguments, env)
+		if errObj != nil {
+			return errObj
 		}
-		return applyFunction(fn, args)
+		return applyFunction(fn, args, kwargs)
 	case *ast.IntegerLiteral:
 		return &object.Integer{Value: node.Value}
+	case *ast.BigIntegerLiteral:
+		return &object.BigInteger{Value: node.Value}
+	case *ast.FloatLiteral:
+		return &object.Float{Value: node.Value}
 	case *ast.Boolean:
 		return toBooleanObject(node.Value)
 	case *ast.StringLiteral:
 		return &object.String{Value: node.Value}
+	case *ast.InterpolatedString:
+		return evalInterpolatedString(node, env)
 	case *ast.ArrayLiteral:
 		elems := evalExpressions(node.Elements, env)
 		// 평가 도중 에러가 발생했다면 항상 에러만 반환됨
@@ -95,9 +133,12 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 		return evalIdentifier(node, env)
 	case *ast.FunctionLiteral:
 		return &object.Function{
-			Params: node.Params,
-			Body:   node.Body,
-			Env:    env,
+			Params:   node.Params,
+			Defaults: node.Defaults,
+			Rest:     node.Rest,
+			Body:     node.Body,
+			Env:      env,
+			Name:     node.Name,
 		}
 	}
 	return nil
@@ -120,6 +161,8 @@ func evalProgram(program *ast.Program, env *object.Environment) object.Object {
 			return result.Value
 		case *object.Error:
 			return result
+		case *object.Break, *object.Continue:
+			return misplacedSignal(result)
 		}
 	}
 	return result
@@ -136,6 +179,9 @@ func evalBlockStatements(block *ast.BlockStatement, env *object.Environment) obj
 				return result
 			case object.ErrorObject:
 				return result
+			// 반복문에서 처리하도록 그대로 반환함
+			case object.BreakObject, object.ContinueObject:
+				return result
 			}
 		}
 	}
@@ -161,17 +207,30 @@ func evalBang(right object.Object) object.Object {
 }
 
 func evalMinus(right object.Object) object.Object {
-	if right.Type() != object.IntegerObject {
+	switch right := right.(type) {
+	case *object.Integer:
+		// -MinInt64 는 int64 범위를 넘으므로 큰 정수로 계산함
+		if right.Value == math.MinInt64 {
+			return object.NewInteger(new(big.Int).Neg(toBigInt(right)))
+		}
+		return &object.Integer{Value: -right.Value}
+	case *object.BigInteger:
+		return object.NewInteger(new(big.Int).Neg(right.Value))
+	case *object.Float:
+		return &object.Float{Value: -right.Value}
+	default:
 		return makeError("unsupported operator: -'%s'", right.Type())
 	}
-
-	return &object.Integer{Value: -right.(*object.Integer).Value}
 }
 
 func evalInfix(op string, left, right object.Object) object.Object {
 	if left.Type() == object.IntegerObject && right.Type() == object.IntegerObject {
 		return evalInfixInteger(op, left, right)
 	}
+	// 정수와 실수를 섞어 계산하면 정수를 실수로 바꿔 계산함
+	if isNumber(left) && isNumber(right) {
+		return evalInfixFloat(op, left, right)
+	}
 	if left.Type() == object.StringObject && right.Type() == object.StringObject {
 		return evalInfixString(op, left, right)
 	}
@@ -184,22 +243,235 @@ func evalInfix(op string, left, right object.Object) object.Object {
 	return makeError("unsupported operator: '%s' %s '%s'", left.Type(), op, right.Type())
 }
 
+// evalAssign 함수는 이미 정의된 이름에 값을 대입하고 대입한 값을 반환함
+// += 같은 복합 대입 연산자는 현재 값과 연산한 결과를 대입함
+func evalAssign(node *ast.AssignExpression, env *object.Environment) object.Object {
+	switch target := node.Target.(type) {
+	case *ast.Identifier:
+		v := evalAssignValue(node, env, func() object.Object {
+			current, ok := env.Get(target.Value)
+			if !ok {
+				return makeError("undefined name: '%s'", target.Value)
+			}
+			return current
+		})
+		if isError(v) {
+			return v
+		}
+		if !env.Assign(target.Value, v) {
+			return makeError("undefined name: '%s'", target.Value)
+		}
+		return v
+	case *ast.IndexExpression:
+		left := Eval(target.Left, env)
+		if isError(left) {
+			return left
+		}
+		index := Eval(target.Index, env)
+		if isError(index) {
+			return index
+		}
+		// print() 처럼 값이 없는 표현식은 null로 취급함
+		if left == nil {
+			left = Null
+		}
+		if index == nil {
+			index = Null
+		}
+		v := evalAssignValue(node, env, func() object.Object {
+			return evalIndex(left, index)
+		})
+		if isError(v) {
+			return v
+		}
+		return evalSetIndex(left, index, v)
+	}
+	return makeError("cannot assign to %s", node.Target)
+}
+
+// evalAssignValue 함수는 대입할 값을 평가함
+// x += y 처럼 복합 대입이라면 current 함수로 읽은 현재 값과 연산한 결과를 반환함
+func evalAssignValue(node *ast.AssignExpression, env *object.Environment, current func() object.Object) object.Object {
+	v := Eval(node.Value, env)
+	if isError(v) {
+		return v
+	}
+	// print() 처럼 값이 없는 표현식은 null로 취급함
+	if v == nil {
+		v = Null
+	}
+
+	op := strings.TrimSuffix(node.Operator, "=")
+	if op == "" {
+		return v
+	}
+	curr := current()
+	if isError(curr) {
+		return curr
+	}
+	return evalInfix(op, curr, v)
+}
+
+// evalLogical 함수는 && 와 || 를 평가하며
+// 왼쪽 피연산자만으로 결과가 정해지면 오른쪽 피연산자를 평가하지 않음
+func evalLogical(node *ast.InfixExpression, left object.Object, env *object.Environment) object.Object {
+	// print() 처럼 값이 없는 표현식은 null로 취급함
+	if left == nil {
+		left = Null
+	}
+	if left.Type() != object.BooleanObject {
+		return makeError("unsupported operand type for %s: '%s'", node.Operator, left.Type())
+	}
+	if (node.Operator == "&&" && left == False) || (node.Operator == "||" && left == True) {
+		return left
+	}
+
+	right := Eval(node.Right, env)
+	if isError(right) {
+		return right
+	}
+	// print() 처럼 값이 없는 표현식은 null로 취급함
+	if right == nil {
+		right = Null
+	}
+	if right.Type() != object.BooleanObject {
+		return makeError("unsupported operator: '%s' %s '%s'", left.Type(), node.Operator, right.Type())
+	}
+	return right
+}
+
 func evalInfixInteger(op string, left, right object.Object) object.Object {
-	l, r := left.(*object.Integer).Value, right.(*object.Integer).Value
+	li, lok := left.(*object.Integer)
+	ri, rok := right.(*object.Integer)
+	if !lok || !rok {
+		return evalInfixBigInteger(op, toBigInt(left), toBigInt(right))
+	}
+	l, r := li.Value, ri.Value
 	switch op {
 	// 정수는 포인터 비교로 동등성을 따질 수 없기에 불 연산자보다 먼저 와야함
+	case "+", "-", "*", "/":
+		if op == "/" && r == 0 {
+			return makeError("division by zero")
+		}
+		v, ok := checkedArithmetic(op, l, r)
+		// 결과가 int64 범위를 넘으면 큰 정수로 다시 계산함
+		if !ok {
+			return evalInfixBigInteger(op, toBigInt(left), toBigInt(right))
+		}
+		return &object.Integer{Value: v}
+	// 나머지의 부호는 나눗셈처럼 0쪽으로 버린 몫을 따라 왼쪽 피연산자의 부호와 같음
+	case "%":
+		if r == 0 {
+			return makeError("modulo by zero")
+		}
+		return &object.Integer{Value: l % r}
+	case "<":
+		return toBooleanObject(l < r)
+	case ">":
+		return toBooleanObject(l > r)
+	case "<=":
+		return toBooleanObject(l <= r)
+	case ">=":
+		return toBooleanObject(l >= r)
+	case "==":
+		return toBooleanObject(l == r)
+	case "!=":
+		return toBooleanObject(l != r)
+	default:
+		return makeError("unsupported operator: '%s' %s '%s'", left.Type(), op, right.Type())
+	}
+}
+
+// evalInfixBigInteger 함수는 int64 범위를 넘는 정수 연산을 계산하며
+// 결과가 다시 int64 범위 안이라면 Integer로 만듦
+func evalInfixBigInteger(op string, l, r *big.Int) object.Object {
+	switch op {
 	case "+":
-		return &object.Integer{Value: l + r}
+		return object.NewInteger(new(big.Int).Add(l, r))
 	case "-":
-		return &object.Integer{Value: l - r}
+		return object.NewInteger(new(big.Int).Sub(l, r))
 	case "*":
-		return &object.Integer{Value: l * r}
+		return object.NewInteger(new(big.Int).Mul(l, r))
+	// Quo, Rem 은 Integer의 나눗셈처럼 몫을 0쪽으로 버림
 	case "/":
-		return &object.Integer{Value: l / r}
+		if r.Sign() == 0 {
+			return makeError("division by zero")
+		}
+		return object.NewInteger(new(big.Int).Quo(l, r))
+	case "%":
+		if r.Sign() == 0 {
+			return makeError("modulo by zero")
+		}
+		return object.NewInteger(new(big.Int).Rem(l, r))
+	case "<":
+		return toBooleanObject(l.Cmp(r) < 0)
+	case ">":
+		return toBooleanObject(l.Cmp(r) > 0)
+	case "<=":
+		return toBooleanObject(l.Cmp(r) <= 0)
+	case ">=":
+		return toBooleanObject(l.Cmp(r) >= 0)
+	case "==":
+		return toBooleanObject(l.Cmp(r) == 0)
+	case "!=":
+		return toBooleanObject(l.Cmp(r) != 0)
+	default:
+		return makeError("unsupported operator: '%s' %s '%s'", object.IntegerObject, op, object.IntegerObject)
+	}
+}
+
+// checkedArithmetic 함수는 정수 사칙연산의 결과를 계산하며
+// 결과가 int64 범위를 넘어 값이 바뀌었다면 false를 반환함
+func checkedArithmetic(op string, l, r int64) (int64, bool) {
+	switch op {
+	case "+":
+		v := l + r
+		return v, (v > l) == (r > 0)
+	case "-":
+		v := l - r
+		return v, (v < l) == (r > 0)
+	case "*":
+		if l == 0 || r == 0 {
+			return 0, true
+		}
+		v := l * r
+		// MinInt64 * -1 은 넘쳐서 다시 MinInt64 가 되므로 나눗셈으로는 알아낼 수 없음
+		if (l == -1 && r == math.MinInt64) || (r == -1 && l == math.MinInt64) {
+			return v, false
+		}
+		return v, v/r == l
+	default:
+		return l / r, !(l == math.MinInt64 && r == -1)
+	}
+}
+
+func evalInfixFloat(op string, left, right object.Object) object.Object {
+	l, r := toFloat(left), toFloat(right)
+	switch op {
+	case "+":
+		return &object.Float{Value: l + r}
+	case "-":
+		return &object.Float{Value: l - r}
+	case "*":
+		return &object.Float{Value: l * r}
+	case "/":
+		if r == 0 {
+			return makeError("division by zero")
+		}
+		return &object.Float{Value: l / r}
+	case "%":
+		if r == 0 {
+			return makeError("modulo by zero")
+		}
+		return &object.Float{Value: math.Mod(l, r)}
 	case "<":
 		return toBooleanObject(l < r)
 	case ">":
 		return toBooleanObject(l > r)
+	case "<=":
+		return toBooleanObject(l <= r)
+	case ">=":
+		return toBooleanObject(l >= r)
 	case "==":
 		return toBooleanObject(l == r)
 	case "!=":
@@ -208,11 +480,70 @@ func evalInfixInteger(op string, left, right object.Object) object.Object {
 		return makeError("unsupported operator: '%s' %s '%s'", left.Type(), op, right.Type())
 	}
 }
+
+func isNumber(obj object.Object) bool {
+	return obj.Type() == object.IntegerObject || obj.Type() == object.FloatObject
+}
+
+func toFloat(obj object.Object) float64 {
+	switch obj := obj.(type) {
+	case *object.Integer:
+		return float64(obj.Value)
+	case *object.BigInteger:
+		f, _ := new(big.Float).SetInt(obj.Value).Float64()
+		return f
+	case *object.Float:
+		return obj.Value
+	default:
+		return 0
+	}
+}
+
+func toBigInt(obj object.Object) *big.Int {
+	switch obj := obj.(type) {
+	case *object.Integer:
+		return big.NewInt(obj.Value)
+	case *object.BigInteger:
+		return obj.Value
+	default:
+		return new(big.Int)
+	}
+}
+
+// toInt64 함수는 인덱스로 쓸 정수 객체의 값을 int64로 바꿈
+// int64 범위를 넘는 큰 정수는 어차피 범위를 벗어나므로 가장 가까운 int64 값으로 바꿈
+func toInt64(obj object.Object) int64 {
+	switch obj := obj.(type) {
+	case *object.Integer:
+		return obj.Value
+	case *object.BigInteger:
+		if obj.Value.Sign() < 0 {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	default:
+		return 0
+	}
+}
+
 func evalInfixString(op string, left, right object.Object) object.Object {
 	l, r := left.(*object.String).Value, right.(*object.String).Value
 	switch op {
 	case "+":
 		return &object.String{Value: l + r}
+	// 문자열은 바이트 단위의 사전순으로 비교함
+	case "<":
+		return toBooleanObject(l < r)
+	case ">":
+		return toBooleanObject(l > r)
+	case "<=":
+		return toBooleanObject(l <= r)
+	case ">=":
+		return toBooleanObject(l >= r)
+	case "==":
+		return toBooleanObject(l == r)
+	case "!=":
+		return toBooleanObject(l != r)
 	default:
 		return makeError("unsupported operator: '%s' %s '%s'", left.Type(), op, right.Type())
 	}
@@ -222,6 +553,9 @@ func evalIndex(left, index object.Object) object.Object {
 	if left.Type() == object.ArrayObject && index.Type() == object.IntegerObject {
 		return evalArrayIndex(left, index)
 	}
+	if left.Type() == object.StringObject && index.Type() == object.IntegerObject {
+		return evalStringIndex(left, index)
+	}
 	if left.Type() == object.HashObject {
 		return evalHashIndex(left, index)
 	}
@@ -230,17 +564,32 @@ func evalIndex(left, index object.Object) object.Object {
 
 func evalArrayIndex(left, index object.Object) object.Object {
 	array := left.(*object.Array)
-	idx := index.(*object.Integer).Value
-	max := int64(len(array.Elements) - 1)
-	if idx < 0 {
-		idx = max + idx + 1
-	}
-	if idx < 0 || idx > max {
+	idx, ok := normalizeIndex(toInt64(index), len(array.Elements))
+	if !ok {
 		return makeError("list index out of range")
 	}
 	return array.Elements[idx]
 }
 
+// 문자열은 바이트가 아닌 글자 단위로 인덱싱함
+func evalStringIndex(left, index object.Object) object.Object {
+	runes := []rune(left.(*object.String).Value)
+	idx, ok := normalizeIndex(toInt64(index), len(runes))
+	if !ok {
+		return makeError("string index out of range")
+	}
+	return &object.String{Value: string(runes[idx])}
+}
+
+// normalizeIndex 함수는 -1 처럼 음수인 인덱스를 뒤에서부터 센 위치로 바꾸고
+// 길이가 length인 배열의 범위 안에 있는지 반환함
+func normalizeIndex(idx int64, length int) (int64, bool) {
+	if idx < 0 {
+		idx += int64(length)
+	}
+	return idx, idx >= 0 && idx < int64(length)
+}
+
 func evalHashIndex(left, index object.Object) object.Object {
 	hash := left.(*object.Hash)
 	key, ok := index.(object.Hashable)
@@ -255,6 +604,111 @@ func evalHashIndex(left, index object.Object) object.Object {
 	return pair.Value
 }
 
+// evalSetIndex 함수는 배열의 원소나 해시의 값을 그 자리에서 바꿈
+func evalSetIndex(left, index, v object.Object) object.Object {
+	if left.Type() == object.ArrayObject && index.Type() == object.IntegerObject {
+		return evalArraySetIndex(left, index, v)
+	}
+	if left.Type() == object.HashObject {
+		return evalHashSetIndex(left, index, v)
+	}
+	return makeError("unsupported index assignment: '%s'", left.Type())
+}
+
+func evalArraySetIndex(left, index, v object.Object) object.Object {
+	array := left.(*object.Array)
+	idx, ok := normalizeIndex(toInt64(index), len(array.Elements))
+	if !ok {
+		return makeError("list index out of range")
+	}
+	array.Elements[idx] = v
+	return v
+}
+
+func evalHashSetIndex(left, index, v object.Object) object.Object {
+	hash := left.(*object.Hash)
+	key, ok := index.(object.Hashable)
+	if !ok {
+		return makeError("unhashable type: '%s'", index.Type())
+	}
+	hash.Pairs[key.HashKey()] = object.HashPair{Key: index, Value: v}
+	return v
+}
+
+func evalSlice(exp *ast.SliceExpression, env *object.Environment) object.Object {
+	left := Eval(exp.Left, env)
+	if isError(left) {
+		return left
+	}
+	// print() 처럼 값이 없는 표현식은 null로 취급함
+	if left == nil {
+		left = Null
+	}
+
+	var length int64
+	switch left := left.(type) {
+	case *object.Array:
+		length = int64(len(left.Elements))
+	case *object.String:
+		length = int64(utf8.RuneCountInString(left.Value))
+	default:
+		return makeError("unsupported slice: '%s'", left.Type())
+	}
+
+	low, errObj := evalSliceBound(exp.Low, 0, length, env)
+	if errObj != nil {
+		return errObj
+	}
+	high, errObj := evalSliceBound(exp.High, length, length, env)
+	if errObj != nil {
+		return errObj
+	}
+	if high < low {
+		high = low
+	}
+
+	switch left := left.(type) {
+	case *object.Array:
+		elems := make([]object.Object, high-low)
+		copy(elems, left.Elements[low:high])
+		return &object.Array{Elements: elems}
+	default:
+		runes := []rune(left.(*object.String).Value)
+		return &object.String{Value: string(runes[low:high])}
+	}
+}
+
+// 음수 인덱스는 뒤에서부터 세며 범위를 벗어난 인덱스는 가장 가까운 경계로 맞춤
+func evalSliceBound(exp ast.Expression, fallback, length int64, env *object.Environment) (int64, object.Object) {
+	if exp == nil {
+		return fallback, nil
+	}
+
+	bound := Eval(exp, env)
+	if isError(bound) {
+		return 0, bound
+	}
+	// print() 처럼 값이 없는 표현식은 null로 취급함
+	if bound == nil {
+		bound = Null
+	}
+	if bound.Type() != object.IntegerObject {
+		return 0, makeError("slice indices must be int: '%s' given", bound.Type())
+	}
+
+	idx := toInt64(bound)
+	if idx < 0 {
+		idx += length
+	}
+	if idx < 0 {
+		return 0, nil
+	}
+	if idx > length {
+		return length, nil
+	}
+	return idx, nil
+}
+
 func evalIf(exp *ast.IfExpression, env *object.Environment) object.Object {
 	cond := Eval(exp.Condition, env)
 	if isError(cond) {
@@ -270,6 +724,133 @@ func evalIf(exp *ast.IfExpression, env *object.Environment) object.Object {
 	return Null
 }
 
+func evalWhile(stmt *ast.WhileStatement, env *object.Environment) object.Object {
+	for {
+		cond := Eval(stmt.Condition, env)
+		if isError(cond) {
+			return cond
+		}
+		// if와 마찬가지로 정확히 true인 값을 따짐
+		if cond != True {
+			return nil
+		}
+
+		if result := evalLoopBody(stmt.Body, env); result != nil {
+			if result == breakSignal {
+				return nil
+			}
+			return result
+		}
+	}
+}
+
+func evalFor(stmt *ast.ForStatement, env *object.Environment) object.Object {
+	iterable := Eval(stmt.Iterable, env)
+	if isError(iterable) {
+		return iterable
+	}
+	// print() 처럼 값이 없는 표현식은 null로 취급함
+	if iterable == nil {
+		iterable = Null
+	}
+
+	result := iterate(iterable, func(elem object.Object) object.Object {
+		// 반복마다 새로운 환경에 변수를 정의해 클로저가 각 반복의 값을 기억하도록 함
+		// while 문과 마찬가지로 본문에서 정의한 다른 이름은 바깥 환경에 남음
+		return evalLoopBody(stmt.Body, env.ExtendWith(stmt.Variable.Value, elem))
+	})
+	if result == breakSignal {
+		return nil
+	}
+	return result
+}
+
+// evalLoopBody 함수는 반복문의 본문을 평가하며
+// 반복을 멈춰야 하는 리턴 값, 에러, break 신호가 아니라면 nil을 반환함
+func evalLoopBody(body *ast.BlockStatement, env *object.Environment) object.Object {
+	result := Eval(body, env)
+	if result == nil {
+		return nil
+	}
+	switch result.Type() {
+	case object.ReturnValueObject, object.ErrorObject, object.BreakObject:
+		return result
+	}
+	return nil
+}
+
+// misplacedSignal 함수는 반복문 밖까지 전달된 break, continue 신호를 에러로 바꿈
+// 파서가 반복문 밖의 break, continue를 막지만 직접 만든 AST를 평가하는 경우를 대비함
+func misplacedSignal(signal object.Object) *object.Error {
+	return makeError("'%s' outside loop", signal)
+}
+
+// iterate 함수는 배열의 원소, 해시의 키, 문자열의 문자, 범위의 정수마다 f를 호출함
+// f가 nil이 아닌 값을 반환하면 반복을 멈추고 그 값을 반환함
+func iterate(iterable object.Object, f func(object.Object) object.Object) object.Object {
+	switch iterable := iterable.(type) {
+	case *object.Array:
+		for _, elem := range iterable.Elements {
+			if result := f(elem); result != nil {
+				return result
+			}
+		}
+	case *object.Hash:
+		// 해시는 순서가 없으므로 키를 정렬해 항상 같은 순서로 반복함
+		keys := make([]object.Object, 0, len(iterable.Pairs))
+		for _, pair := range iterable.Pairs {
+			keys = append(keys, pair.Key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return lessKey(keys[i], keys[j])
+		})
+		for _, key := range keys {
+			if result := f(key); result != nil {
+				return result
+			}
+		}
+	case *object.String:
+		for _, r := range iterable.Value {
+			if result := f(&object.String{Value: string(r)}); result != nil {
+				return result
+			}
+		}
+	case *object.Range:
+		// i*Step 이 넘치더라도 범위 안의 값은 int64로 나타낼 수 있으므로 넘친 만큼 되돌아와 올바른 값이 됨
+		for i, n := uint64(0), iterable.Len(); i < n; i++ {
+			if result := f(&object.Integer{Value: iterable.Start + int64(i)*iterable.Step}); result != nil {
+				return result
+			}
+		}
+	default:
+		return makeError("not iterable: '%s'", iterable.Type())
+	}
+	return nil
+}
+
+// lessKey 함수는 해시 키를 반복할 순서에서 a가 b보다 앞서는지 반환함
+// 숫자는 다른 타입보다 앞에 두고 크기 순으로 정렬하며, 나머지는 타입 이름과 문자열 순으로 정렬함
+func lessKey(a, b object.Object) bool {
+	switch {
+	case isNumber(a) && isNumber(b):
+		if a.Type() == object.IntegerObject && b.Type() == object.IntegerObject {
+			return toBigInt(a).Cmp(toBigInt(b)) < 0
+		}
+		l, r := toFloat(a), toFloat(b)
+		// NaN은 어떤 수와도 크기를 비교할 수 없으므로 가장 뒤에 둠
+		if math.IsNaN(l) || math.IsNaN(r) {
+			return !math.IsNaN(l) && math.IsNaN(r)
+		}
+		return l < r
+	case isNumber(a) != isNumber(b):
+		return isNumber(a)
+	case a.Type() != b.Type():
+		return a.Type() < b.Type()
+	default:
+		return a.String() < b.String()
+	}
+}
+
 func evalHash(node *ast.HashLiteral, env *object.Environment) object.Object {
 	pairs := make(map[object.HashKey]object.HashPair)
 
@@ -300,6 +881,27 @@ func evalHash(node *ast.HashLiteral, env *object.Environment) object.Object {
 	}
 }
 
+func evalInterpolatedString(node *ast.InterpolatedString, env *object.Environment) object.Object {
+	var out strings.Builder
+	for i, s := range node.Strings {
+		_, _ = out.WriteString(s)
+		if i >= len(node.Exprs) {
+			break
+		}
+
+		v := Eval(node.Exprs[i], env)
+		if isError(v) {
+			return v
+		}
+		// print() 처럼 값이 없는 표현식은 null로 보여줌
+		if v == nil {
+			v = Null
+		}
+		_, _ = out.WriteString(v.String())
+	}
+	return &object.String{Value: out.String()}
+}
+
 func evalIdentifier(node *ast.Identifier, env *object.Environment) object.Object {
 	if v, ok := env.Get(node.Value); ok {
 		return v
@@ -324,27 +926,165 @@ func evalExpressions(exps []ast.Expression, env *object.Environment) []object.Ob
 	return results
 }
 
-func applyFunction(obj object.Object, args []object.Object) object.Object {
+// evalArguments 함수는 호출 인자를 평가해 위치 인자와 이름별 키워드 인자로 나눔
+func evalArguments(exps []ast.Expression, env *object.Environment) ([]object.Object, map[string]object.Object, object.Object) {
+	args := make([]object.Object, 0, len(exps))
+	var kwargs map[string]object.Object
+	for _, exp := range exps {
+		kw, ok := exp.(*ast.KeywordArgument)
+		if ok {
+			exp = kw.Value
+		}
+		evaluated := Eval(exp, env)
+		if isError(evaluated) {
+			return nil, nil, evaluated
+		}
+		// 내장 함수가 nil을 받지 않도록 print() 처럼 값이 없는 인자는 null로 넘김
+		if evaluated == nil {
+			evaluated = Null
+		}
+		if !ok {
+			args = append(args, evaluated)
+			continue
+		}
+		if kwargs == nil {
+			kwargs = make(map[string]object.Object)
+		}
+		kwargs[kw.Name.Value] = evaluated
+	}
+	return args, kwargs, nil
+}
+
+func applyFunction(obj object.Object, args []object.Object, kwargs map[string]object.Object) object.Object {
 	switch fn := obj.(type) {
 	case *object.Function:
-		env := fn.Env.Extend()
-		for i, p := range fn.Params {
-			env.Set(p.Value, args[i])
+		env, errObj := bindArguments(fn, args, kwargs)
+		if errObj != nil {
+			return errObj
 		}
 
 		evaluated := Eval(fn.Body, env)
+		switch v := evaluated.(type) {
 		// unwrap
-		if v, ok := evaluated.(*object.ReturnValue); ok {
+		case *object.ReturnValue:
 			return v.Value
+		case *object.Break, *object.Continue:
+			return misplacedSignal(v)
 		}
 		return evaluated
 	case *object.Builtin:
+		if fn.KeywordFn != nil {
+			return fn.KeywordFn(kwargs, args...)
+		}
+		if len(kwargs) > 0 {
+			return makeError("%s() takes no keyword arguments", fn.Name)
+		}
 		return fn.Fn(args...)
 	default:
 		return makeError("not a function: %s", obj.Type())
 	}
 }
 
+// bindArguments 함수는 함수를 호출할 환경을 만들어 파라미터에 위치 인자와 키워드 인자를 바인딩함
+// 인자가 없는 파라미터는 기본값을 호출 환경에서 평가해 바인딩하므로 기본값에서 앞선 파라미터를 참조할 수 있음
+func bindArguments(fn *object.Function, args []object.Object, kwargs map[string]object.Object) (*object.Environment, *object.Error) {
+	if fn.Rest == nil && len(args) > len(fn.Params) {
+		return nil, makeError("%s() takes %s: %d given", functionName(fn), arity(fn), len(args))
+	}
+	for _, name := range keywordNames(kwargs) {
+		i := paramIndex(fn, name)
+		if i < 0 {
+			return nil, makeError("%s() got an unexpected keyword argument '%s'", functionName(fn), name)
+		}
+		if i < len(args) {
+			return nil, makeError("%s() got multiple values for argument '%s'", functionName(fn), name)
+		}
+	}
+
+	required := len(fn.Params) - len(fn.Defaults)
+	env := fn.Env.Extend()
+	for i, p := range fn.Params {
+		if i < len(args) {
+			env.Set(p.Value, args[i])
+			continue
+		}
+		if v, ok := kwargs[p.Value]; ok {
+			env.Set(p.Value, v)
+			continue
+		}
+		if i < required {
+			if len(kwargs) == 0 {
+				return nil, makeError("%s() takes %s: %d given", functionName(fn), arity(fn), len(args))
+			}
+			return nil, makeError("%s() missing argument: '%s'", functionName(fn), p.Value)
+		}
+		v := Eval(fn.Defaults[i-required], env)
+		if err, ok := v.(*object.Error); ok {
+			return nil, err
+		}
+		if v == nil {
+			v = Null
+		}
+		env.Set(p.Value, v)
+	}
+	if fn.Rest != nil {
+		var rest []object.Object
+		if len(args) > len(fn.Params) {
+			rest = append(rest, args[len(fn.Params):]...)
+		}
+		env.Set(fn.Rest.Value, &object.Array{Elements: rest})
+	}
+	return env, nil
+}
+
+// keywordNames 함수는 키워드 인자의 이름을 정렬해 반환함
+// 여러 키워드 인자가 잘못됐을 때 항상 같은 에러를 보여주도록 이 순서로 검사함
+func keywordNames(kwargs map[string]object.Object) []string {
+	names := make([]string, 0, len(kwargs))
+	for name := range kwargs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func paramIndex(fn *object.Function, name string) int {
+	for i, p := range fn.Params {
+		if p.Value == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// arity 함수는 2 arguments, 1 to 2 arguments, at least 1 argument 처럼 함수가 받는 인자 개수를 나타냄
+func arity(fn *object.Function) string {
+	required := len(fn.Params) - len(fn.Defaults)
+	switch {
+	case fn.Rest != nil:
+		return "at least " + pluralize(required, "argument")
+	case required < len(fn.Params):
+		return fmt.Sprintf("%d to %s", required, pluralize(len(fn.Params), "argument"))
+	default:
+		return pluralize(required, "argument")
+	}
+}
+
+func functionName(fn *object.Function) string {
+	if fn.Name == "" {
+		return "<anonymous>"
+	}
+	return fn.Name
+}
+
+// pluralize 함수는 1 argument, 2 arguments 처럼 개수에 맞는 형태로 단어를 붙임
+func pluralize(n int, word string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, word)
+	}
+	return fmt.Sprintf("%d %ss", n, word)
+}
+
 func makeError(format string, args ...any) *object.Error {
 	return &object.Error{Message: fmt.Sprintf(format, args...)}
 }
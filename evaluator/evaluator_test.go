@@ -1,12 +1,15 @@
 package evaluator
 
 import (
+	"math"
+	"strings"
 	"testing"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go-interpreter/ast"
 	"go-interpreter/lexer"
 	"go-interpreter/object"
 	"go-interpreter/parser"
@@ -30,8 +33,14 @@ func TestEvalInteger(t *testing.T) {
 		{input: "4 * 4", expected: 16},
 		{input: "0 / 42", expected: 0},
 		{input: "4 * (2 + 3)", expected: 20},
-		// TODO: division by zero 검증하기
-		//{input: "1 / 0", expected: 0},
+		{input: "7 % 3", expected: 1},
+		{input: "-7 % 3", expected: -1},
+		{input: "7 % -3", expected: 1},
+		{input: "1 + 10 % 4 * 2", expected: 5},
+		{input: "9223372036854775807 - 1 + 1", expected: math.MaxInt64},
+		{input: "-9223372036854775807 - 1", expected: math.MinInt64},
+		{input: "(-9223372036854775807 - 1) % -1", expected: 0},
+		{input: "4611686018427387904 * -2", expected: math.MinInt64},
 	}
 	for _, tc := range cases {
 		t.Run(tc.input, func(t *testing.T) {
@@ -41,6 +50,79 @@ func TestEvalInteger(t *testing.T) {
 	}
 }
 
+func TestEvalBigInteger(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "99999999999999999999", expected: "99999999999999999999"},
+		{input: "0xffff_ffff_ffff_ffff", expected: "18446744073709551615"},
+		// int64 범위를 넘는 연산은 큰 정수로 계산함
+		{input: "9223372036854775807 + 1", expected: "9223372036854775808"},
+		{input: "-9223372036854775807 - 2", expected: "-9223372036854775809"},
+		{input: "3037000500 * 3037000500", expected: "9223372037000250000"},
+		{input: "(-9223372036854775807 - 1) * -1", expected: "9223372036854775808"},
+		{input: "(-9223372036854775807 - 1) / -1", expected: "9223372036854775808"},
+		{input: "-(-9223372036854775807 - 1)", expected: "9223372036854775808"},
+		{input: "let f = fn(n) { if (n <= 1) { 1 } else { n * f(n - 1) } }; f(25)", expected: "15511210043330985984000000"},
+		{input: "99999999999999999999 / 7", expected: "14285714285714285714"},
+		{input: "-99999999999999999999 / 7", expected: "-14285714285714285714"},
+		{input: "-99999999999999999999 % 7", expected: "-1"},
+		{input: "99999999999999999999 < 100000000000000000000", expected: "true"},
+		{input: "99999999999999999999 == 99999999999999999999", expected: "true"},
+		{input: "99999999999999999999 != 1", expected: "true"},
+		{input: "99999999999999999999 > 1.5", expected: "true"},
+		{input: "99999999999999999999 + 0.5", expected: "1e+20"},
+		{input: "let x = 9223372036854775807; x += 1; x", expected: "9223372036854775808"},
+		{input: "[1, 2, 3][99999999999999999999 - 99999999999999999998]", expected: "2"},
+		{input: "[1, 2, 3][-99999999999999999999:99999999999999999999]", expected: "[1, 2, 3]"},
+		{input: "{99999999999999999999: 1}[99999999999999999998 + 1]", expected: "1"},
+		{input: "{100000000000000000000: 1}[1e20]", expected: "1"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			evaluated := evalFromString(t, tc.input)
+			require.NotNil(t, evaluated)
+			assert.Equal(t, tc.expected, evaluated.String())
+		})
+	}
+
+	// int64 범위로 돌아온 결과는 다시 작은 정수가 됨
+	t.Run("normalize", func(t *testing.T) {
+		assertInteger(t, evalFromString(t, "9223372036854775807 + 1 - 1"), math.MaxInt64)
+		assertInteger(t, evalFromString(t, "99999999999999999999 - 99999999999999999998"), 1)
+		assertInteger(t, evalFromString(t, "-(9223372036854775807 + 1)"), math.MinInt64)
+	})
+}
+
+func TestEvalFloat(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    string
+		expected float64
+	}{
+		{input: "3.14", expected: 3.14},
+		{input: "-1.5", expected: -1.5},
+		{input: "1.5 + 1.5", expected: 3},
+		{input: "1 + 0.5", expected: 1.5},
+		{input: "0.5 * 4", expected: 2},
+		{input: "7 / 2.0", expected: 3.5},
+		{input: "(1 + 2 + 3) / 4.0", expected: 1.5},
+		{input: "1e3 - 1", expected: 999},
+		{input: "7.5 % 2", expected: 1.5},
+		{input: "7 % 2.5", expected: 2},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			evaluated := evalFromString(t, tc.input)
+			assertFloat(t, evaluated, tc.expected)
+		})
+	}
+}
+
 func TestEvalBoolean(t *testing.T) {
 	t.Parallel()
 
@@ -66,6 +148,23 @@ func TestEvalBoolean(t *testing.T) {
 		{input: "false == false", expected: true},
 		{input: "(1 > 2) == false", expected: true},
 		{input: "(1 == 2) == false", expected: true},
+		{input: "1.5 < 2", expected: true},
+		{input: "2 > 2.5", expected: false},
+		{input: "1 == 1.0", expected: true},
+		{input: "0.1 != 0.2", expected: true},
+		{input: "1 <= 1", expected: true},
+		{input: "2 <= 1", expected: false},
+		{input: "1 >= 2", expected: false},
+		{input: "2 >= 2", expected: true},
+		{input: "1.5 <= 1", expected: false},
+		{input: "2 >= 1.5", expected: true},
+		{input: `"a" == "a"`, expected: true},
+		{input: `"a" != "a"`, expected: false},
+		{input: `"apple" < "banana"`, expected: true},
+		{input: `"apple" > "app"`, expected: true},
+		{input: `"b" <= "a"`, expected: false},
+		{input: `"가" >= "a"`, expected: true},
+		{input: `"" < "a"`, expected: true},
 		// TODO: 아직 null은 직접 파싱하지 않음
 		//{input: "null == null", expected: true},
 		//{input: "null == true", expected: false},
@@ -79,6 +178,44 @@ func TestEvalBoolean(t *testing.T) {
 	}
 }
 
+func TestEvalLogical(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    string
+		expected any
+	}{
+		{input: "true && true", expected: true},
+		{input: "true && false", expected: false},
+		{input: "false || true", expected: true},
+		{input: "false || false", expected: false},
+		{input: "1 < 2 && 2 < 3", expected: true},
+		{input: "1 > 2 || 2 > 3 || 3 > 2", expected: true},
+		// 왼쪽 피연산자로 결과가 정해지면 오른쪽 피연산자를 평가하지 않음
+		{input: "false && undefined", expected: false},
+		{input: "true || undefined", expected: true},
+		{input: "true && undefined", expected: errors.New("undefined name: 'undefined'")},
+		{input: "1 && true", expected: errors.New("unsupported operand type for &&: 'int'")},
+		{input: `"a" || true`, expected: errors.New("unsupported operand type for ||: 'string'")},
+		{input: "print() && true", expected: errors.New("unsupported operand type for &&: 'null'")},
+		{input: "print() || true", expected: errors.New("unsupported operand type for ||: 'null'")},
+		{input: "true && 1", expected: errors.New("unsupported operator: 'bool' && 'int'")},
+		{input: "false || if (false) { 1 }", expected: errors.New("unsupported operator: 'bool' || 'null'")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			evaluated := evalFromString(t, tc.input)
+
+			switch expected := tc.expected.(type) {
+			case bool:
+				assertBoolean(t, evaluated, expected)
+			case error:
+				assertError(t, evaluated, expected.Error())
+			}
+		})
+	}
+}
+
 func TestEvalString(t *testing.T) {
 	t.Parallel()
 
@@ -87,8 +224,9 @@ func TestEvalString(t *testing.T) {
 		expected string
 	}{
 		{input: `"hello world!"`, expected: "hello world!"},
-		// TODO: \n 제대로 지원하기
-		{input: `"hello\nworld!"`, expected: "hello\\nworld!"},
+		{input: `"hello\nworld!"`, expected: "hello\nworld!"},
+		{input: `"\"quoted\" \\ \u{D55C}\u{AE00}"`, expected: `"quoted" \ 한글`},
+		{input: "`raw\\n\nstring`", expected: "raw\\n\nstring"},
 		{input: `"hello" + " " + "world!"`, expected: "hello world!"},
 	}
 	for _, tc := range cases {
@@ -99,6 +237,35 @@ func TestEvalString(t *testing.T) {
 	}
 }
 
+func TestEvalInterpolatedString(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    string
+		expected any
+	}{
+		{input: `let name = "me"; "hello ${name}!"`, expected: "hello me!"},
+		{input: `let age = 20; "you are ${age + 1}"`, expected: "you are 21"},
+		{input: `"${1.5} ${true} ${[1, "a"]} ${{"k": 1}}"`, expected: "1.5 true [1, a] {k: 1}"},
+		{input: `"${"nested ${1 + 1}"}"`, expected: "nested 2"},
+		{input: `"${print}"`, expected: "builtin function"},
+		{input: `"price: \${x}"`, expected: "price: ${x}"},
+		{input: `"${undefined}"`, expected: errors.New("undefined name: 'undefined'")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			evaluated := evalFromString(t, tc.input)
+
+			switch expected := tc.expected.(type) {
+			case string:
+				assertString(t, evaluated, expected)
+			case error:
+				assertError(t, evaluated, expected.Error())
+			}
+		})
+	}
+}
+
 func TestEvalArray(t *testing.T) {
 	t.Parallel()
 
@@ -136,6 +303,10 @@ func TestEvalArrayIndex(t *testing.T) {
 		{input: "let a = [1, 2]; a[0] + a[1];", expected: 3},
 		{input: "[1, 2][2]", expected: errors.New("list index out of range")},
 		{input: "[1, 2][-3]", expected: errors.New("list index out of range")},
+		{input: "[1, 2][99999999999999999999]", expected: errors.New("list index out of range")},
+		{input: "[1, 2][-99999999999999999999]", expected: errors.New("list index out of range")},
+		{input: `"ab"[99999999999999999999]`, expected: errors.New("string index out of range")},
+		{input: "let a = [1]; a[99999999999999999999] = 2", expected: errors.New("list index out of range")},
 	}
 	for _, tc := range cases {
 		t.Run(tc.input, func(t *testing.T) {
@@ -151,6 +322,73 @@ func TestEvalArrayIndex(t *testing.T) {
 	}
 }
 
+func TestEvalStringIndex(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    string
+		expected any
+	}{
+		{input: `"abc"[0]`, expected: "a"},
+		{input: `"abc"[-1]`, expected: "c"},
+		{input: `"안녕하세요"[1]`, expected: "녕"},
+		{input: `"안녕하세요"[-1]`, expected: "요"},
+		{input: `"안녕"[2]`, expected: errors.New("string index out of range")},
+		{input: `"안녕"[-3]`, expected: errors.New("string index out of range")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			evaluated := evalFromString(t, tc.input)
+
+			switch expected := tc.expected.(type) {
+			case string:
+				assertString(t, evaluated, expected)
+			case error:
+				assertError(t, evaluated, expected.Error())
+			}
+		})
+	}
+}
+
+func TestEvalSlice(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    string
+		expected any
+	}{
+		{input: `[1, 2, 3][1:]`, expected: []int{2, 3}},
+		{input: `[1, 2, 3][:2]`, expected: []int{1, 2}},
+		{input: `[1, 2, 3][:]`, expected: []int{1, 2, 3}},
+		{input: `[1, 2, 3][-2:]`, expected: []int{2, 3}},
+		{input: `[1, 2, 3][1:-1]`, expected: []int{2}},
+		{input: `[1, 2, 3][2:1]`, expected: []int{}},
+		{input: `[1, 2, 3][-10:10]`, expected: []int{1, 2, 3}},
+		{input: `"hello"[1:3]`, expected: "el"},
+		{input: `"안녕하세요"[2:]`, expected: "하세요"},
+		{input: `"안녕하세요"[:-3]`, expected: "안녕"},
+		{input: `"abc"["a":]`, expected: errors.New("slice indices must be int: 'string' given")},
+		{input: `{"a": 1}[1:]`, expected: errors.New("unsupported slice: 'hash'")},
+		{input: `print()[1:]`, expected: errors.New("unsupported slice: 'null'")},
+		{input: `let x = [1, 2, 3]; x[print():]`, expected: errors.New("slice indices must be int: 'null' given")},
+		{input: `let x = [1, 2, 3]; x[:print()]`, expected: errors.New("slice indices must be int: 'null' given")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			evaluated := evalFromString(t, tc.input)
+
+			switch expected := tc.expected.(type) {
+			case []int:
+				assertArray(t, evaluated, expected)
+			case string:
+				assertString(t, evaluated, expected)
+			case error:
+				assertError(t, evaluated, expected.Error())
+			}
+		})
+	}
+}
+
 func TestEvalHash(t *testing.T) {
 	t.Parallel()
 
@@ -267,6 +505,160 @@ func TestEvalReturn(t *testing.T) {
 	}
 }
 
+func TestEvalWhile(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    string
+		expected any
+	}{
+		{input: "let i = 0; while (i < 5) { let i = i + 1 }; i", expected: 5},
+		{input: "let i = 10; while (i < 5) { let i = i + 1 }; i", expected: 10},
+		{input: "let i = 0; while (i < 3) { let i = i + 1 }", expected: nil},
+		// 본문에서 정의한 이름은 반복문 밖에서도 보임
+		{input: "let s = 0; let i = 0; while (i < 3) { let i = i + 1; let s = s + i }; s", expected: 6},
+		{input: "let i = 0; while (i < 1) { let i = i + 1; let t = 5 }; t", expected: 5},
+		{input: "let f = fn() { let i = 0; while (true) { let i = i + 1; if (i == 3) { return i } } }; f()", expected: 3},
+		{input: "while (1 + true) { 1 }", expected: errors.New("unsupported operator: 'int' + 'bool'")},
+		{input: "let i = 0; while (i < 3) { let i = i + 1; i + true }", expected: errors.New("unsupported operator: 'int' + 'bool'")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			evaluated := evalFromString(t, tc.input)
+
+			switch expected := tc.expected.(type) {
+			case int:
+				assertInteger(t, evaluated, int64(expected))
+			case error:
+				assertError(t, evaluated, expected.Error())
+			default:
+				require.Nil(t, evaluated)
+			}
+		})
+	}
+}
+
+func TestEvalFor(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    string
+		expected any
+	}{
+		{input: "let f = fn() { for (x in [1, 2, 3]) { if (x > 1) { return x } } }; f()", expected: 2},
+		{input: "let f = fn() { for (x in range(10, 0, -3)) { if (x < 5) { return x } } }; f()", expected: 4},
+		{input: `let f = fn() { for (c in "한글") { return c } }; f()`, expected: "한"},
+		{input: `let f = fn() { for (k in {"b": 1, "a": 2}) { return k } }; f()`, expected: "a"},
+		{input: "for (x in []) { x + true }", expected: nil},
+		{input: "for (x in [1]) { x }", expected: nil},
+		// 반복 변수는 반복문 밖으로 새지 않음
+		{input: "for (x in [1]) { x }; x", expected: errors.New("undefined name: 'x'")},
+		{input: "for (x in [1]) { let x = x + 1 }; x", expected: errors.New("undefined name: 'x'")},
+		// while 문과 마찬가지로 본문에서 정의한 이름은 반복문 밖에서도 보임
+		{input: "let s = 0; for (x in [1, 2, 3]) { let s = s + x }; s", expected: 6},
+		{input: "for (x in [1]) { let t = 5 }; t", expected: 5},
+		// 클로저는 각 반복의 값을 기억함
+		{input: "let f = fn() { for (x in [1, 2]) { return fn() { x } } }; f()()", expected: 1},
+		// 개수가 int64 범위를 넘는 범위도 끝까지 반복함
+		{input: "let m = 9223372036854775807; let n = 0; for (x in range(-m - 1, m, m)) { n += 1 }; n", expected: 3},
+		{input: "let m = 9223372036854775807; let f = fn() { for (x in range(m, -m - 1, -m - 1)) { if (x < 0) { return x } } }; f()", expected: -1},
+		{input: "for (x in 1) { x }", expected: errors.New("not iterable: 'int'")},
+		{input: "for (x in print()) { x }", expected: errors.New("not iterable: 'null'")},
+		{input: "for (x in [1, true]) { -x }", expected: errors.New("unsupported operator: -'bool'")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			evaluated := evalFromString(t, tc.input)
+
+			switch expected := tc.expected.(type) {
+			case int:
+				assertInteger(t, evaluated, int64(expected))
+			case string:
+				assertString(t, evaluated, expected)
+			case error:
+				assertError(t, evaluated, expected.Error())
+			default:
+				require.Nil(t, evaluated)
+			}
+		})
+	}
+}
+
+func TestEvalBreakContinue(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    string
+		expected any
+	}{
+		{input: "let i = 0; while (true) { let i = i + 1; if (i == 3) { break } }; i", expected: 3},
+		{input: "let i = 0; while (i < 5) { let i = i + 1; continue; let i = 100 }; i", expected: 5},
+		{input: "let f = fn() { for (x in range(5)) { if (x < 3) { continue } return x } }; f()", expected: 3},
+		{input: "let f = fn() { for (x in range(5)) { if (x == 1) { break } return x } }; f()", expected: 0},
+		// break는 가장 안쪽의 반복문만 멈춤
+		{input: "let i = 0; while (i < 3) { let i = i + 1; while (true) { break } }; i", expected: 3},
+		{input: "let f = fn() { for (x in range(3)) { for (y in range(3)) { if (y == 1) { break } } return x } }; f()", expected: 0},
+		{input: "for (x in range(3)) { break }", expected: nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			evaluated := evalFromString(t, tc.input)
+
+			switch expected := tc.expected.(type) {
+			case int:
+				assertInteger(t, evaluated, int64(expected))
+			default:
+				require.Nil(t, evaluated)
+			}
+		})
+	}
+
+	// 파서는 반복문 밖의 break, continue를 막으므로 AST를 직접 만들어 확인함
+	t.Run("outside loop", func(t *testing.T) {
+		program := &ast.Program{
+			Statements: []ast.Statement{&ast.ContinueStatement{}},
+		}
+		assertError(t, Eval(program, object.NewEnvironment()), "'continue' outside loop")
+
+		fn := &object.Function{
+			Body: &ast.BlockStatement{Statements: []ast.Statement{&ast.BreakStatement{}}},
+			Env:  object.NewEnvironment(),
+		}
+		assertError(t, applyFunction(fn, nil, nil), "'break' outside loop")
+	})
+}
+
+func TestIterate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    string
+		expected []string
+	}{
+		{input: "[1, true, \"a\"]", expected: []string{"1", "true", "a"}},
+		{input: `{"b": 1, "a": 2, "c": 3}`, expected: []string{"a", "b", "c"}},
+		// 숫자 키는 크기 순으로 다른 타입의 키보다 먼저 반복함
+		{input: `{"x": 1, 10: 2, true: 3, 2: 4, "10": 5, -3: 6, 1.5: 7, 99999999999999999999: 8}`, expected: []string{"-3", "1.5", "2", "10", "99999999999999999999", "true", "10", "x"}},
+		{input: `"한글"`, expected: []string{"한", "글"}},
+		{input: "range(3)", expected: []string{"0", "1", "2"}},
+		{input: "range(1, 3)", expected: []string{"1", "2"}},
+		{input: "range(0, 10, 4)", expected: []string{"0", "4", "8"}},
+		{input: "range(3, 0, -1)", expected: []string{"3", "2", "1"}},
+		{input: "range(3, 0)", expected: nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			var elems []string
+			result := iterate(evalFromString(t, tc.input), func(elem object.Object) object.Object {
+				elems = append(elems, elem.String())
+				return nil
+			})
+			require.Nil(t, result)
+			require.Equal(t, tc.expected, elems)
+		})
+	}
+}
+
 func TestError(t *testing.T) {
 	t.Parallel()
 
@@ -308,6 +700,102 @@ func TestError(t *testing.T) {
 			input:    `"hello" - "world"`,
 			expected: "unsupported operator: 'string' - 'string'",
 		},
+		{
+			input:    `"hello" % "world"`,
+			expected: "unsupported operator: 'string' % 'string'",
+		},
+		{
+			input:    `"1" <= 2`,
+			expected: "unsupported operator: 'string' <= 'int'",
+		},
+		{
+			input:    "1 / 0",
+			expected: "division by zero",
+		},
+		{
+			input:    "1 % 0",
+			expected: "modulo by zero",
+		},
+		{
+			input:    "1.5 / 0",
+			expected: "division by zero",
+		},
+		{
+			input:    "1 % 0.0",
+			expected: "modulo by zero",
+		},
+		{
+			input:    "let x = 1; x /= 0",
+			expected: "division by zero",
+		},
+		{
+			input:    "99999999999999999999 / 0",
+			expected: "division by zero",
+		},
+		{
+			input:    "99999999999999999999 % 0",
+			expected: "modulo by zero",
+		},
+		{
+			input:    "99999999999999999999 && true",
+			expected: "unsupported operand type for &&: 'int'",
+		},
+		{
+			input:    "let add = fn(a, b) { a + b }; add(1)",
+			expected: "add() takes 2 arguments: 1 given",
+		},
+		{
+			input:    "let add = fn(a, b) { a + b }; add(1, 2, 3)",
+			expected: "add() takes 2 arguments: 3 given",
+		},
+		{
+			input:    "let id = fn(x) { x }; let f = id; f()",
+			expected: "id() takes 1 argument: 0 given",
+		},
+		{
+			input:    "let f = fn() { 1 }; f(1)",
+			expected: "f() takes 0 arguments: 1 given",
+		},
+		{
+			input:    "fn(x) { x }(1, 2)",
+			expected: "<anonymous>() takes 1 argument: 2 given",
+		},
+		{
+			input:    "let f = fn(x, y = 1) { x }; f()",
+			expected: "f() takes 1 to 2 arguments: 0 given",
+		},
+		{
+			input:    "let f = fn(x, y = 1) { x }; f(1, 2, 3)",
+			expected: "f() takes 1 to 2 arguments: 3 given",
+		},
+		{
+			input:    "let f = fn(x, ...xs) { x }; f()",
+			expected: "f() takes at least 1 argument: 0 given",
+		},
+		{
+			input:    "let f = fn(x = y) { x }; f()",
+			expected: "undefined name: 'y'",
+		},
+		{
+			input:    "let f = fn(x) { x }; f(y: 1)",
+			expected: "f() got an unexpected keyword argument 'y'",
+		},
+		{
+			input:    "let f = fn(x, ...xs) { x }; f(1, xs: 1)",
+			expected: "f() got an unexpected keyword argument 'xs'",
+		},
+		{
+			input:    "let f = fn(x) { x }; f(1, x: 2)",
+			expected: "f() got multiple values for argument 'x'",
+		},
+		{
+			input:    "let f = fn(x, y) { x }; f(y: 1)",
+			expected: "f() missing argument: 'x'",
+		},
+		{
+			input:    "let f = fn(x) { x }; f(x: y)",
+			expected: "undefined name: 'y'",
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.input, func(t *testing.T) {
@@ -317,6 +805,33 @@ func TestError(t *testing.T) {
 	}
 }
 
+func TestErrorSpan(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input string
+		start string
+		end   string
+	}{
+		{input: "1;\n5 + true", start: "2:1", end: "2:9"},
+		{input: "let x = foobar;", start: "1:9", end: "1:15"},
+		// 함수 안에서 발생한 에러는 호출한 곳이 아닌 함수 본문의 위치를 가리킴
+		{input: "let f = fn() { -true };\nf()", start: "1:16", end: "1:21"},
+		{input: `len(1, 2)`, start: "1:1", end: "1:10"},
+		{input: "(1 + 2) * true", start: "1:1", end: "1:15"},
+		{input: "1 + (-true)", start: "1:5", end: "1:12"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			evaluated := evalFromString(t, tc.input)
+			err, ok := evaluated.(*object.Error)
+			require.Truef(t, ok, "expected: *object.Error, got: %T", evaluated)
+			assert.Equal(t, tc.start, err.Span.Start.String())
+			assert.Equal(t, tc.end, err.Span.Stop.String())
+		})
+	}
+}
+
 func TestEvalLet(t *testing.T) {
 	t.Parallel()
 
@@ -339,6 +854,100 @@ func TestEvalLet(t *testing.T) {
 	}
 }
 
+func TestEvalAssign(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    string
+		expected any
+	}{
+		{input: "let x = 1; x = 2; x", expected: 2},
+		{input: "let x = 1; x = 2", expected: 2},
+		{input: "let x = 1; let y = 2; x = y = 3; x + y", expected: 6},
+		{input: "let x = 10; x += 5; x -= 3; x *= 2; x /= 4; x %= 4; x", expected: 2},
+		{input: `let s = "a"; s += "b"; s`, expected: "ab"},
+		{input: "let x = 1; x += 0.5; x", expected: 1.5},
+		// 바깥 환경에 정의된 이름을 바꿈
+		{input: "let n = 0; let inc = fn() { n += 1 }; inc(); inc(); n", expected: 2},
+		{input: "let counter = fn() { let n = 0; fn() { n = n + 1 } }; let c = counter(); c(); c()", expected: 2},
+		{input: "let total = 0; for (x in range(5)) { total += x }; total", expected: 10},
+		// 함수 안에서 let으로 정의한 이름은 바깥 환경의 이름을 가림
+		{input: "let n = 0; let f = fn() { let n = 1; n = 2 }; f(); n", expected: 0},
+		{input: "let a = [1, 2, 3]; a[0] = 10; a[-1] += 5; a[0] + a[1] + a[2]", expected: 20},
+		{input: "let a = [[1], [2]]; a[1][0] = 3; a[1][0]", expected: 3},
+		{input: `let h = {"a": 1}; h["a"] *= 3; h["b"] = 2; h["a"] + h["b"]`, expected: 5},
+		{input: "let h = {}; h[true] = 1; h[1] = 2; h[true] + h[1]", expected: 3},
+		// 배열과 해시는 그 자리에서 바뀌므로 같은 값을 가리키는 모든 이름에 반영됨
+		{input: "let a = [1]; let b = a; b[0] = 2; a[0]", expected: 2},
+		{input: "let set = fn(h) { h[\"k\"] = 1 }; let h = {}; set(h); h[\"k\"]", expected: 1},
+		{input: "let a = [1]; a[1] = 2", expected: errors.New("list index out of range")},
+		{input: "let a = [1]; a[-2] = 2", expected: errors.New("list index out of range")},
+		{input: "let h = {}; h[[]] = 1", expected: errors.New("unhashable type: 'array'")},
+		{input: "let h = {}; h[print()] = 1", expected: errors.New("unhashable type: 'null'")},
+		{input: "let h = {}; h[print()] += 1", expected: errors.New("unhashable type: 'null'")},
+		{input: "let a = [1]; a[print()] = 1", expected: errors.New("unsupported index assignment: 'array'")},
+		{input: "let q = print(); q[0] = 1", expected: errors.New("unsupported index assignment: 'null'")},
+		{input: `let h = {}; h["k"] += 1`, expected: errors.New("unsupported operator: 'null' + 'int'")},
+		{input: `let s = "abc"; s[0] = "d"`, expected: errors.New("unsupported index assignment: 'string'")},
+		{input: `let a = [1]; a["0"] = 1`, expected: errors.New("unsupported index assignment: 'array'")},
+		{input: "x[0] = 1", expected: errors.New("undefined name: 'x'")},
+		{input: "x = 1", expected: errors.New("undefined name: 'x'")},
+		{input: "x += 1", expected: errors.New("undefined name: 'x'")},
+		{input: "let f = fn() { y = 1 }; f()", expected: errors.New("undefined name: 'y'")},
+		{input: "let x = 1; x += true", expected: errors.New("unsupported operator: 'int' + 'bool'")},
+		{input: "let x = 1; x = -true; x", expected: errors.New("unsupported operator: -'bool'")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			evaluated := evalFromString(t, tc.input)
+
+			switch expected := tc.expected.(type) {
+			case int:
+				assertInteger(t, evaluated, int64(expected))
+			case float64:
+				assertFloat(t, evaluated, expected)
+			case string:
+				assertString(t, evaluated, expected)
+			case error:
+				assertError(t, evaluated, expected.Error())
+			}
+		})
+	}
+}
+
+func TestEvalFunctionParams(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "let f = fn(x, y = 10) { [x, y] }; f(1)", expected: "[1, 10]"},
+		{input: "let f = fn(x, y = 10) { [x, y] }; f(1, 2)", expected: "[1, 2]"},
+		// 기본값은 호출할 때마다 호출 환경에서 평가하므로 앞선 파라미터를 참조할 수 있음
+		{input: "let f = fn(x, y = x * 2) { [x, y] }; [f(1), f(2)]", expected: "[[1, 2], [2, 4]]"},
+		{input: "let n = 1; let f = fn(x = n) { x }; let a = f(); n = 2; [a, f()]", expected: "[1, 2]"},
+		// 기본값은 호출마다 새로 만들어지므로 이전 호출에서 바꾼 값이 남지 않음
+		{input: "let f = fn(a = [0]) { a[0] += 1; a[0] }; f(); f()", expected: "1"},
+		{input: "let f = fn(...xs) { xs }; f()", expected: "[]"},
+		{input: "let f = fn(...xs) { xs }; f(1, 2, 3)", expected: "[1, 2, 3]"},
+		{input: "let f = fn(x, y = 2, ...xs) { [x, y, xs] }; f(1)", expected: "[1, 2, []]"},
+		{input: "let f = fn(x, y = 2, ...xs) { [x, y, xs] }; f(1, 3, 5, 7)", expected: "[1, 3, [5, 7]]"},
+		{input: "let f = fn(x, y) { [x, y] }; f(y: 1, x: 2)", expected: "[2, 1]"},
+		{input: "let f = fn(x, y = 2, z = 3) { [x, y, z] }; f(1, z: 4)", expected: "[1, 2, 4]"},
+		{input: "let f = fn(x, y = x + 1) { [x, y] }; f(x: 1)", expected: "[1, 2]"},
+		{input: "let f = fn(x, ...xs) { [x, xs] }; f(x: 1)", expected: "[1, []]"},
+		{input: "let sum = fn(...xs) { let total = 0; for (x in xs) { total += x }; total }; sum(1, 2, 3)", expected: "6"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			evaluated := evalFromString(t, tc.input)
+			require.NotNil(t, evaluated)
+			assert.Equal(t, tc.expected, evaluated.String())
+		})
+	}
+}
+
 func TestEvalFunctionObject(t *testing.T) {
 	input := "fn(x) { x + 2; };"
 
@@ -446,12 +1055,48 @@ func TestEvalBuiltinFunctions(t *testing.T) {
 	}{
 		{input: `len("")`, expected: 0},
 		{input: `len("four")`, expected: 4},
+		{input: `len("안녕하세요")`, expected: 5},
 		{input: `len(1)`, expected: errors.New("unsupported argument type of len(): 'int'")},
 		{input: `len("one", "two")`, expected: errors.New("len() takes exactly one argument: 2 given")},
 		{input: `len()`, expected: errors.New("len() takes exactly one argument: 0 given")},
 		{input: `len([])`, expected: 0},
 		{input: `len([1, 2])`, expected: 2},
 		{input: `len([1, 2], [3, 4])`, expected: errors.New("len() takes exactly one argument: 2 given")},
+		{input: `float(1)`, expected: 1.0},
+		{input: `float(2.5)`, expected: 2.5},
+		{input: `float("0.25")`, expected: 0.25},
+		{input: `float("abc")`, expected: errors.New("invalid literal for float(): 'abc'")},
+		{input: `float(true)`, expected: errors.New("unsupported argument type of float(): 'bool'")},
+		{input: `float()`, expected: errors.New("float() takes exactly one argument: 0 given")},
+		{input: `int(2.9)`, expected: 2},
+		{input: `int(-2.9)`, expected: -2},
+		{input: `int(7)`, expected: 7},
+		{input: `int("42")`, expected: 42},
+		{input: `int("4.2")`, expected: errors.New("invalid literal for int(): '4.2'")},
+		{input: `int(1e20)`, expected: "100000000000000000000"},
+		{input: `int("123456789012345678901234567890")`, expected: "123456789012345678901234567890"},
+		{input: `int(99999999999999999999)`, expected: "99999999999999999999"},
+		{input: `float(99999999999999999999)`, expected: 1e20},
+		{input: `float(1` + strings.Repeat("0", 400) + `)`, expected: errors.New("int too large to convert to float")},
+		{input: `range(99999999999999999999)`, expected: errors.New("range() argument too large: 99999999999999999999")},
+		{input: `int([])`, expected: errors.New("unsupported argument type of int(): 'array'")},
+		{input: `int(print())`, expected: errors.New("unsupported argument type of int(): 'null'")},
+		{input: `float(print())`, expected: errors.New("unsupported argument type of float(): 'null'")},
+		{input: `len(print())`, expected: errors.New("unsupported argument type of len(): 'null'")},
+		{input: `range(5)`, expected: "range(0, 5)"},
+		{input: `range(1, 5)`, expected: "range(1, 5)"},
+		{input: `range(5, 1, -2)`, expected: "range(5, 1, -2)"},
+		{input: `len(range(5, 1, -2))`, expected: 2},
+		{input: `range()`, expected: errors.New("range() takes 1 to 3 arguments: 0 given")},
+		{input: `range(1, 2, 3, 4)`, expected: errors.New("range() takes 1 to 3 arguments: 4 given")},
+		{input: `range(1.5)`, expected: errors.New("range() arguments must be int: 'float' given")},
+		{input: `range(1, 5, 0)`, expected: errors.New("range() step must not be zero")},
+		{input: `range(print())`, expected: errors.New("range() arguments must be int: 'null' given")},
+		{input: `len(range(0, 9223372036854775807, 10))`, expected: 922337203685477581},
+		{input: `len(range(-9223372036854775807 - 1, 9223372036854775807))`, expected: "18446744073709551615"},
+		{input: `len("a", x: 1)`, expected: errors.New("len() takes no keyword arguments")},
+		{input: `print(1, sep: 1)`, expected: errors.New("print() argument 'sep' must be string: 'int' given")},
+		{input: `print(1, end: "", file: "a")`, expected: errors.New("print() got an unexpected keyword argument 'file'")},
 	}
 	for _, tc := range cases {
 		t.Run(tc.input, func(t *testing.T) {
@@ -460,6 +1105,10 @@ func TestEvalBuiltinFunctions(t *testing.T) {
 			switch expected := tc.expected.(type) {
 			case int:
 				assertInteger(t, evaluated, int64(expected))
+			case float64:
+				assertFloat(t, evaluated, expected)
+			case string:
+				require.Equal(t, expected, evaluated.String())
 			case error:
 				assertError(t, evaluated, expected.Error())
 			}
@@ -489,6 +1138,14 @@ func assertInteger(t *testing.T, obj object.Object, expected int64) {
 	require.Equal(t, expected, i.Value)
 }
 
+func assertFloat(t *testing.T, obj object.Object, expected float64) {
+	t.Helper()
+
+	f, ok := obj.(*object.Float)
+	require.Truef(t, ok, "expected: *object.Float, got: %T", obj)
+	require.Equal(t, expected, f.Value)
+}
+
 func assertBoolean(t *testing.T, obj object.Object, expected bool) {
 	t.Helper()
 
@@ -1,38 +1,100 @@
 package lexer
 
 import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
 	"unicode"
+	"unicode/utf8"
 
+	"github.com/hashicorp/go-multierror"
+
+	"go-interpreter/diagnostic"
 	"go-interpreter/token"
 )
 
 type Lexer struct {
-	input string
-	// 현재 위치 (현재 문자)
-	position int
-	// 현재 읽는 위치 (현재 문자의 다음)
-	// 다음 문자를 미리 살펴봄과 동시에 현재 문자를 보존할 수 있어야 하기에 존재
-	readPosition int
+	// 렉싱 중 발생한 에러
+	// 에러가 발생한 곳은 token.ILLEGAL 토큰을 반환하거나 가능하면 렉싱을 이어감
+	Errs *multierror.Error
+	// true면 주석을 건너뛰지 않고 token.COMMENT 토큰으로 반환함
+	// 포매터처럼 주석을 보존해야 하는 곳에서 사용함
+	KeepComments bool
+
+	// 입력 전체를 메모리에 올리지 않고 버퍼 단위로 읽어옴
+	// 다음 문자를 미리 살펴볼 때도 버퍼를 이용함
+	r *bufio.Reader
+	// 입력을 읽다가 발생한 에러 (io.EOF 제외)
+	err error
+
 	// 현재 조사하고 있는 문자
-	// TODO: rune 타입으로 바꾸고 읽는 방식을 바꿔 유니코드 지원
-	ch byte
+	// UTF-8로 인코딩 된 입력을 rune 단위로 읽어 유니코드를 지원함
+	ch rune
+	// 현재 조사하고 있는 문자의 바이트 크기, 입력의 끝이라면 0
+	size int
+	// 현재 조사하고 있는 문자의 위치
+	position token.Position
+	// 렉싱 중인 문자열 보간 ${...} 마다 아직 닫히지 않은 { 의 개수를 쌓아둠
+	// 개수가 0일 때 만나는 } 는 보간의 끝이므로 이어서 문자열을 읽음
+	interps []interpolation
+}
+
+// interpolation은 닫히지 않은 문자열 보간 하나의 상태를 나타냄
+type interpolation struct {
+	// 보간을 포함한 문자열의 시작 위치
+	start token.Position
+	// 보간 안에서 아직 닫히지 않은 { 의 개수
+	braces int
 }
 
 const (
 	eof = 0
 )
 
-// TODO: io.Reader와 파일 이름으로 초기화 해 토큰에 파일 이름과 행 번호를 붙여,
-// 렉싱과 파싱에서 생긴 에러를 더 쉽게 추적하도록 만들기
+// New 함수는 문자열 전체를 입력으로 받는 렉서를 생성함
 func New(input string) *Lexer {
-	l := &Lexer{input: input}
+	return NewReader(strings.NewReader(input), "")
+}
+
+// NewReader 함수는 r에서 입력을 조금씩 읽어오는 렉서를 생성하며
+// 생성하는 모든 토큰의 위치에 filename을 붙임
+func NewReader(r io.Reader, filename string) *Lexer {
+	l := &Lexer{
+		r: bufio.NewReader(r),
+		position: token.Position{
+			Filename: filename,
+			Offset:   0,
+			Line:     1,
+			Column:   1,
+		},
+	}
 	l.readChar()
 	return l
 }
 
+// Err 메서드는 입력을 읽다가 발생한 에러를 반환함
+func (l *Lexer) Err() error {
+	return l.err
+}
+
 func (l *Lexer) NextToken() token.Token {
+	for {
+		l.skipWhitespace()
+
+		pos := l.pos()
+		tok := l.readToken()
+		tok.Pos = pos
+		tok.End = l.pos()
+		if tok.Type == token.COMMENT && !l.KeepComments {
+			continue
+		}
+		return tok
+	}
+}
+
+func (l *Lexer) readToken() token.Token {
 	var tok token.Token
-	l.skipWhitespace()
 	switch l.ch {
 	case '=':
 		switch l.peekChar() {
@@ -45,10 +107,32 @@ func (l *Lexer) NextToken() token.Token {
 		default:
 			tok = newToken(token.ASSIGN, l.ch)
 		}
+	case '&':
+		switch l.peekChar() {
+		case '&':
+			l.readChar()
+			tok = token.Token{
+				Type:    token.AND,
+				Literal: "&&",
+			}
+		default:
+			tok = l.illegalChar()
+		}
+	case '|':
+		switch l.peekChar() {
+		case '|':
+			l.readChar()
+			tok = token.Token{
+				Type:    token.OR,
+				Literal: "||",
+			}
+		default:
+			tok = l.illegalChar()
+		}
 	case '+':
-		tok = newToken(token.PLUS, l.ch)
+		tok = l.withAssign(token.PLUS, token.PLUSASSIGN)
 	case '-':
-		tok = newToken(token.MINUS, l.ch)
+		tok = l.withAssign(token.MINUS, token.MINUSASSIGN)
 	case '!':
 		switch l.peekChar() {
 		case '=':
@@ -61,40 +145,118 @@ func (l *Lexer) NextToken() token.Token {
 			tok = newToken(token.BANG, l.ch)
 		}
 	case '/':
-		tok = newToken(token.SLASH, l.ch)
+		switch l.peekChar() {
+		case '/':
+			// 줄바꿈은 공백으로 건너뛰도록 남겨둠
+			return token.Token{
+				Type:    token.COMMENT,
+				Literal: l.readLineComment(),
+			}
+		case '*':
+			s, ok := l.readBlockComment()
+			tok = token.Token{
+				Type:    token.COMMENT,
+				Literal: s,
+			}
+			if !ok {
+				tok.Type = token.ILLEGAL
+			}
+		default:
+			tok = l.withAssign(token.SLASH, token.SLASHASSIGN)
+		}
 	case '*':
-		tok = newToken(token.ASTERISK, l.ch)
+		tok = l.withAssign(token.ASTERISK, token.ASTERISKASSIGN)
+	case '%':
+		tok = l.withAssign(token.PERCENT, token.PERCENTASSIGN)
 	case '<':
-		tok = newToken(token.LT, l.ch)
+		switch l.peekChar() {
+		case '=':
+			l.readChar()
+			tok = token.Token{
+				Type:    token.LTE,
+				Literal: "<=",
+			}
+		default:
+			tok = newToken(token.LT, l.ch)
+		}
 	case '>':
-		tok = newToken(token.GT, l.ch)
+		switch l.peekChar() {
+		case '=':
+			l.readChar()
+			tok = token.Token{
+				Type:    token.GTE,
+				Literal: ">=",
+			}
+		default:
+			tok = newToken(token.GT, l.ch)
+		}
 	case ',':
 		tok = newToken(token.COMMA, l.ch)
 	case ';':
 		tok = newToken(token.SEMICOLON, l.ch)
 	case ':':
 		tok = newToken(token.COLON, l.ch)
+	case '.':
+		tok = l.readEllipsis()
 	case '(':
 		tok = newToken(token.LPAREN, l.ch)
 	case ')':
 		tok = newToken(token.RPAREN, l.ch)
 	case '{':
+		if n := len(l.interps); n > 0 {
+			l.interps[n-1].braces++
+		}
 		tok = newToken(token.LBRACE, l.ch)
 	case '}':
+		n := len(l.interps)
+		if n > 0 && l.interps[n-1].braces == 0 {
+			start := l.interps[n-1].start
+			l.interps = l.interps[:n-1]
+			s, tokenType := l.readString(start, true)
+			tok = token.Token{
+				Type:    tokenType,
+				Literal: s,
+			}
+			break
+		}
+		if n > 0 {
+			l.interps[n-1].braces--
+		}
 		tok = newToken(token.RBRACE, l.ch)
 	case '[':
 		tok = newToken(token.LBRACKET, l.ch)
 	case ']':
 		tok = newToken(token.RBRACKET, l.ch)
 	case eof:
-		tok = token.Token{
+		// 보간이 닫히지 않은 채 입력이 끝났다면 한 번만 에러를 기록하고 ILLEGAL 토큰을 반환함
+		if len(l.interps) > 0 {
+			l.markAsError(diagnostic.UnterminatedString, l.interps[0].start, "unterminated string interpolation").
+				WithNote("close the interpolation with '}' and the string with '\"'")
+			l.interps = nil
+			return token.Token{
+				Type:    token.ILLEGAL,
+				Literal: "",
+			}
+		}
+		// 입력의 끝에서 더 이상 위치를 옮기지 않음
+		return token.Token{
 			Type:    token.EOF,
 			Literal: "",
 		}
 	case '"':
+		s, tokenType := l.readString(l.pos(), false)
+		tok = token.Token{
+			Type:    tokenType,
+			Literal: s,
+		}
+	case '`':
+		s, ok := l.readRawString()
 		tok = token.Token{
 			Type:    token.STRING,
-			Literal: l.readString(),
+			Literal: s,
+		}
+		if !ok {
+			tok.Type = token.ILLEGAL
 		}
 	// 앞에서 처리되지 않으면 식별자로 처리
 	default:
@@ -108,87 +270,361 @@ func (l *Lexer) NextToken() token.Token {
 			// l.readChar()를 수행하지 않고 반환함
 			return tok
 		}
-		if unicode.IsDigit(rune(l.ch)) {
-			return token.Token{
-				Type:    token.INTEGER,
-				Literal: l.readNumber(),
-			}
+		if isDecimal(l.ch) {
+			return l.readNumber()
 		}
-		tok = newToken(token.ILLEGAL, l.ch)
+		tok = l.illegalChar()
 	}
 	l.readChar()
 	return tok
 }
 
+// withAssign 메서드는 다음 문자가 = 라면 += 같은 복합 대입 연산자 토큰을,
+// 아니라면 현재 문자의 연산자 토큰을 반환함
+func (l *Lexer) withAssign(op, assign token.Type) token.Token {
+	if l.peekChar() != '=' {
+		return newToken(op, l.ch)
+	}
+	ch := l.ch
+	l.readChar()
+	return token.Token{
+		Type:    assign,
+		Literal: string(ch) + "=",
+	}
+}
+
+// readEllipsis 메서드는 ... 토큰을 읽으며 점이 세 개가 아니라면 에러로 기록함
+func (l *Lexer) readEllipsis() token.Token {
+	if l.peekChar() != '.' {
+		return l.illegalChar()
+	}
+	start := l.pos()
+	var sb strings.Builder
+	sb.WriteRune(l.ch)
+	for sb.Len() < len(token.ELLIPSIS) && l.peekChar() == '.' {
+		l.readChar()
+		sb.WriteRune(l.ch)
+	}
+	if sb.String() != token.ELLIPSIS {
+		l.markAsError(diagnostic.IllegalCharacter, start, "illegal character %q", sb.String())
+		return token.Token{
+			Type:    token.ILLEGAL,
+			Literal: sb.String(),
+		}
+	}
+	return token.Token{
+		Type:    token.ELLIPSIS,
+		Literal: token.ELLIPSIS,
+	}
+}
+
+// illegalChar 메서드는 현재 문자를 알 수 없는 문자로 기록하고 ILLEGAL 토큰을 반환함
+func (l *Lexer) illegalChar() token.Token {
+	l.markAsError(diagnostic.IllegalCharacter, l.pos(), "illegal character %q", l.ch)
+	return newToken(token.ILLEGAL, l.ch)
+}
+
 // 렉서가 현재 보고 있는 위치를 다음으로 이동하는 메서드
 func (l *Lexer) readChar() {
-	if l.readPosition >= len(l.input) {
+	// 입력의 끝에 다다르면 더 이상 위치를 옮기지 않음
+	if l.size > 0 {
+		l.position.Offset += l.size
+		if l.ch == '\n' {
+			l.position.Line++
+			l.position.Column = 1
+		} else {
+			l.position.Column++
+		}
+	}
+
+	// 잘못 인코딩 된 바이트는 utf8.RuneError로 읽혀 token.ILLEGAL이 됨
+	ch, size, err := l.r.ReadRune()
+	if err != nil {
+		if err != io.EOF {
+			l.err = err
+		}
 		l.ch = eof
-	} else {
-		l.ch = l.input[l.readPosition]
+		l.size = 0
+		return
 	}
-	l.position = l.readPosition
-	l.readPosition++
+	l.ch = ch
+	l.size = size
+}
+
+// 현재 조사하고 있는 문자의 위치
+func (l *Lexer) pos() token.Position {
+	return l.position
 }
 
 // 현재 위치를 바꾸지 않고 다음 문자만 살펴보는 메서드
-func (l *Lexer) peekChar() byte {
-	if l.readPosition >= len(l.input) {
+func (l *Lexer) peekChar() rune {
+	// 입력의 끝 근처에선 utf8.UTFMax 보다 적게 읽힐 수 있으므로 에러는 무시함
+	b, _ := l.r.Peek(utf8.UTFMax)
+	if len(b) == 0 {
 		return eof
 	}
-	return l.input[l.readPosition]
+	ch, _ := utf8.DecodeRune(b)
+	return ch
 }
 
 // 문자가 아닐 때 까지 글자를 읽어 문자열을 반환함
 func (l *Lexer) readIdentifier() string {
-	start := l.position
+	var sb strings.Builder
 	for isLetter(l.ch) {
+		_, _ = sb.WriteRune(l.ch)
 		l.readChar()
 	}
-	return l.input[start:l.position]
+	return sb.String()
 }
 
-func (l *Lexer) readNumber() string {
-	start := l.position
+// 정수나 실수(e.g. 3.14, 1e-9, 1_000.5)를 읽어 토큰을 반환함
+func (l *Lexer) readNumber() token.Token {
+	var sb strings.Builder
+	tokenType := token.Type(token.INTEGER)
+
+	// 0x, 0o, 0b 접두사가 붙은 16진수, 8진수, 2진수 정수
+	if l.ch == '0' && isBasePrefix(l.peekChar()) {
+		_, _ = sb.WriteRune(l.ch)
+		l.readChar()
+		_, _ = sb.WriteRune(l.ch)
+		l.readChar()
+		// 진법에 맞지 않는 숫자(e.g. 0b102)는 파서에서 에러로 처리함
+		for isHexDigit(l.ch) {
+			_, _ = sb.WriteRune(l.ch)
+			l.readChar()
+		}
+		return token.Token{
+			Type:    tokenType,
+			Literal: sb.String(),
+		}
+	}
+
+	l.readDigits(&sb)
+	// 1.foo 같은 문법과 구분하기 위해 . 다음에 숫자가 올 때만 소수점으로 판단함
+	if l.ch == '.' && isDecimal(l.peekChar()) {
+		tokenType = token.FLOAT
+		_, _ = sb.WriteRune(l.ch)
+		l.readChar()
+		l.readDigits(&sb)
+	}
+	if l.ch == 'e' || l.ch == 'E' {
+		tokenType = token.FLOAT
+		_, _ = sb.WriteRune(l.ch)
+		l.readChar()
+		if l.ch == '+' || l.ch == '-' {
+			_, _ = sb.WriteRune(l.ch)
+			l.readChar()
+		}
+		// 지수가 비어있는 1e 같은 잘못된 리터럴은 파서에서 에러로 처리함
+		l.readDigits(&sb)
+	}
+
+	return token.Token{
+		Type:    tokenType,
+		Literal: sb.String(),
+	}
+}
+
+func (l *Lexer) readDigits(sb *strings.Builder) {
 	for isDigit(l.ch) {
+		_, _ = sb.WriteRune(l.ch)
+		l.readChar()
+	}
+}
+
+// 줄이 끝날 때까지 // 주석을 읽음
+func (l *Lexer) readLineComment() string {
+	var sb strings.Builder
+	for l.ch != '\n' && l.ch != eof {
+		_, _ = sb.WriteRune(l.ch)
 		l.readChar()
 	}
-	return l.input[start:l.position]
+	return sb.String()
 }
 
-func (l *Lexer) readString() string {
-	start := l.position + 1 // " 다음부터
+// */ 가 나올 때까지 /* 주석을 읽으며 중첩된 주석은 지원하지 않음
+// 주석이 닫히지 않은 채 입력이 끝나면 false를 반환함
+func (l *Lexer) readBlockComment() (string, bool) {
+	start := l.pos()
+	var sb strings.Builder
+	_, _ = sb.WriteRune(l.ch) // /
+	l.readChar()
+	_, _ = sb.WriteRune(l.ch) // *
 	for {
 		l.readChar()
-		if l.ch == '\\' && l.peekChar() == '"' {
+		switch {
+		case l.ch == eof:
+			l.markAsError(diagnostic.UnterminatedComment, start, "unterminated block comment")
+			return sb.String(), false
+		case l.ch == '*' && l.peekChar() == '/':
+			_, _ = sb.WriteRune(l.ch)
 			l.readChar()
-			continue
+			_, _ = sb.WriteRune(l.ch)
+			return sb.String(), true
+		default:
+			_, _ = sb.WriteRune(l.ch)
 		}
-		if l.ch == '"' || l.ch == eof {
-			break
+	}
+}
+
+// 큰따옴표로 감싼 문자열을 이스케이프 시퀀스를 해석하며 읽음
+// ${ 를 만나면 보간할 표현식을 렉싱하기 위해 읽기를 멈추며
+// resumed가 true면 보간이 끝난 } 다음부터 이어서 읽는 것이며 start는 처음 문자열이 시작된 위치임
+// 줄이 바뀌거나 입력이 끝날 때까지 문자열이 닫히지 않으면 token.ILLEGAL을 반환함
+func (l *Lexer) readString(start token.Position, resumed bool) (string, token.Type) {
+	var sb strings.Builder
+	for {
+		l.readChar()
+		switch l.ch {
+		case '"':
+			if resumed {
+				return sb.String(), token.INTERPTAIL
+			}
+			return sb.String(), token.STRING
+		case '$':
+			if l.peekChar() != '{' {
+				_, _ = sb.WriteRune(l.ch)
+				continue
+			}
+			l.readChar()
+			l.interps = append(l.interps, interpolation{start: start})
+			if resumed {
+				return sb.String(), token.INTERPMIDDLE
+			}
+			return sb.String(), token.INTERPHEAD
+		case '\n', eof:
+			l.markAsError(diagnostic.UnterminatedString, start, "unterminated string literal").
+				WithNote("use a raw string literal (`...`) to write a string over multiple lines")
+			return sb.String(), token.ILLEGAL
+		case '\\':
+			l.readEscape(&sb)
+		default:
+			_, _ = sb.WriteRune(l.ch)
 		}
 	}
+}
 
-	return l.input[start:l.position]
+// 백틱으로 감싼 문자열은 이스케이프 시퀀스를 해석하지 않고 여러 줄에 걸칠 수 있음
+func (l *Lexer) readRawString() (string, bool) {
+	start := l.pos()
+	var sb strings.Builder
+	for {
+		l.readChar()
+		switch l.ch {
+		case '`':
+			return sb.String(), true
+		case eof:
+			l.markAsError(diagnostic.UnterminatedString, start, "unterminated raw string literal")
+			return sb.String(), false
+		default:
+			_, _ = sb.WriteRune(l.ch)
+		}
+	}
+}
+
+var escapes = map[rune]rune{
+	'n':  '\n',
+	't':  '\t',
+	'r':  '\r',
+	'\\': '\\',
+	'"':  '"',
+	'$':  '$',
+}
+
+// 현재 문자인 \ 다음의 이스케이프 시퀀스를 해석해 sb에 씀
+func (l *Lexer) readEscape(sb *strings.Builder) {
+	pos := l.pos()
+	next := l.peekChar()
+	if ch, ok := escapes[next]; ok {
+		l.readChar()
+		_, _ = sb.WriteRune(ch)
+		return
+	}
+	if next == 'u' {
+		l.readChar()
+		l.readUnicodeEscape(pos, sb)
+		return
+	}
+	// 줄바꿈이나 입력의 끝은 readString에서 닫히지 않은 문자열로 처리함
+	if next == '\n' || next == eof {
+		return
+	}
+	l.readChar()
+	l.markAsError(diagnostic.InvalidEscape, pos, "unknown escape sequence: \\%c", next).
+		WithNote(`supported escape sequences are \n, \t, \r, \\, \", \$ and \u{...}`)
+}
+
+// \u{1F600} 형태의 유니코드 이스케이프 시퀀스를 해석함
+func (l *Lexer) readUnicodeEscape(pos token.Position, sb *strings.Builder) {
+	if l.peekChar() != '{' {
+		l.markAsError(diagnostic.InvalidEscape, pos, "invalid unicode escape sequence: missing '{'")
+		return
+	}
+	l.readChar()
+
+	var hex strings.Builder
+	for isHexDigit(l.peekChar()) && l.peekChar() != '_' {
+		l.readChar()
+		_, _ = hex.WriteRune(l.ch)
+	}
+	if l.peekChar() != '}' {
+		l.markAsError(diagnostic.InvalidEscape, pos, "invalid unicode escape sequence: missing '}'")
+		return
+	}
+	l.readChar()
+
+	code, err := strconv.ParseUint(hex.String(), 16, 32)
+	if err != nil || !utf8.ValidRune(rune(code)) {
+		l.markAsError(diagnostic.InvalidEscape, pos, "invalid unicode code point: %q", hex.String())
+		return
+	}
+	_, _ = sb.WriteRune(rune(code))
+}
+
+// markAsError 메서드는 렉싱 과정에서 발생한 에러를 start부터 현재 위치까지의 범위와 함께 저장함
+// 부가 설명을 덧붙일 수 있도록 저장한 진단을 반환함
+func (l *Lexer) markAsError(code diagnostic.Code, start token.Position, format string, args ...any) *diagnostic.Diagnostic {
+	d := diagnostic.Errorf(code, start, l.pos(), format, args...)
+	l.Errs = multierror.Append(l.Errs, d)
+	return d
 }
 
 func (l *Lexer) skipWhitespace() {
-	for unicode.IsSpace(rune(l.ch)) {
+	for unicode.IsSpace(l.ch) {
 		l.readChar()
 	}
 }
 
 // 식별자의 허용 문자 범위를 결정하는 함수
-func isLetter(ch byte) bool {
-	return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_'
+// 한글처럼 유니코드에서 문자로 분류되는 모든 글자를 허용함
+func isLetter(ch rune) bool {
+	return unicode.IsLetter(ch) || ch == '_'
+}
+
+// 숫자 리터럴을 시작할 수 있는 문자인지 판단하는 함수
+// unicode.IsDigit은 아라비아 숫자 외의 숫자도 포함하므로 사용하지 않음
+func isDecimal(ch rune) bool {
+	return '0' <= ch && ch <= '9'
+}
+
+func isDigit(ch rune) bool {
+	return isDecimal(ch) || ch == '_' // 100_000 형태 지원
+}
+
+func isHexDigit(ch rune) bool {
+	return isDigit(ch) || 'a' <= ch && ch <= 'f' || 'A' <= ch && ch <= 'F'
 }
 
-// TODO: 정수 이외에 실수형, 16진수 등도 지원
-func isDigit(ch byte) bool {
-	return '0' <= ch && ch <= '9' || ch == '_' // 100_000 형태 지원
+func isBasePrefix(ch rune) bool {
+	switch ch {
+	case 'x', 'X', 'o', 'O', 'b', 'B':
+		return true
+	default:
+		return false
+	}
 }
 
-func newToken(tokenType token.Type, ch byte) token.Token {
+func newToken(tokenType token.Type, ch rune) token.Token {
 	return token.Token{
 		Type:    tokenType,
 		Literal: string(ch),
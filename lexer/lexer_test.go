@@ -1,7 +1,11 @@
 package lexer
 
 import (
+	"errors"
+	"io"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/require"
 
@@ -88,27 +92,81 @@ let result = add(five, ten);
 			},
 		},
 		{
-			name:  "arithmetic operators",
-			input: "!-/*5;\n",
+			name: "arithmetic operators",
+			// /* 는 블록 주석의 시작이므로 띄어씀
+			input: "!-/ *%5;\n",
 			expected: []token.Token{
 				{Type: token.BANG, Literal: "!"},
 				{Type: token.MINUS, Literal: "-"},
 				{Type: token.SLASH, Literal: "/"},
 				{Type: token.ASTERISK, Literal: "*"},
+				{Type: token.PERCENT, Literal: "%"},
 				{Type: token.INTEGER, Literal: "5"},
 				{Type: token.SEMICOLON, Literal: ";"},
 			},
 		},
 		{
 			name:  "comparison operators",
-			input: "5 < 10 > 5;",
+			input: "5 < 10 > 5 <= 6 >= 7 <=> 8;",
 			expected: []token.Token{
 				{Type: token.INTEGER, Literal: "5"},
 				{Type: token.LT, Literal: "<"},
 				{Type: token.INTEGER, Literal: "10"},
 				{Type: token.GT, Literal: ">"},
 				{Type: token.INTEGER, Literal: "5"},
+				{Type: token.LTE, Literal: "<="},
+				{Type: token.INTEGER, Literal: "6"},
+				{Type: token.GTE, Literal: ">="},
+				{Type: token.INTEGER, Literal: "7"},
+				{Type: token.LTE, Literal: "<="},
+				{Type: token.GT, Literal: ">"},
+				{Type: token.INTEGER, Literal: "8"},
+				{Type: token.SEMICOLON, Literal: ";"},
+			},
+		},
+		{
+			name:  "assignment operators",
+			input: "x = 1; x += 1; x -= 1; x *= 1; x /= 1; x %= 1; x==1",
+			expected: []token.Token{
+				{Type: token.IDENTIFIER, Literal: "x"},
+				{Type: token.ASSIGN, Literal: "="},
+				{Type: token.INTEGER, Literal: "1"},
+				{Type: token.SEMICOLON, Literal: ";"},
+				{Type: token.IDENTIFIER, Literal: "x"},
+				{Type: token.PLUSASSIGN, Literal: "+="},
+				{Type: token.INTEGER, Literal: "1"},
+				{Type: token.SEMICOLON, Literal: ";"},
+				{Type: token.IDENTIFIER, Literal: "x"},
+				{Type: token.MINUSASSIGN, Literal: "-="},
+				{Type: token.INTEGER, Literal: "1"},
+				{Type: token.SEMICOLON, Literal: ";"},
+				{Type: token.IDENTIFIER, Literal: "x"},
+				{Type: token.ASTERISKASSIGN, Literal: "*="},
+				{Type: token.INTEGER, Literal: "1"},
+				{Type: token.SEMICOLON, Literal: ";"},
+				{Type: token.IDENTIFIER, Literal: "x"},
+				{Type: token.SLASHASSIGN, Literal: "/="},
+				{Type: token.INTEGER, Literal: "1"},
+				{Type: token.SEMICOLON, Literal: ";"},
+				{Type: token.IDENTIFIER, Literal: "x"},
+				{Type: token.PERCENTASSIGN, Literal: "%="},
+				{Type: token.INTEGER, Literal: "1"},
 				{Type: token.SEMICOLON, Literal: ";"},
+				{Type: token.IDENTIFIER, Literal: "x"},
+				{Type: token.EQ, Literal: "=="},
+				{Type: token.INTEGER, Literal: "1"},
+			},
+		},
+		{
+			name:  "logical operators",
+			input: "a && b || !c",
+			expected: []token.Token{
+				{Type: token.IDENTIFIER, Literal: "a"},
+				{Type: token.AND, Literal: "&&"},
+				{Type: token.IDENTIFIER, Literal: "b"},
+				{Type: token.OR, Literal: "||"},
+				{Type: token.BANG, Literal: "!"},
+				{Type: token.IDENTIFIER, Literal: "c"},
 			},
 		},
 		{
@@ -138,6 +196,29 @@ let result = add(five, ten);
 				{Type: token.RBRACE, Literal: "}"},
 			},
 		},
+		{
+			name:  "while, for, in, break, continue",
+			input: `while (x) { for (y in z) { break; continue } }`,
+			expected: []token.Token{
+				{Type: token.WHILE, Literal: "while"},
+				{Type: token.LPAREN, Literal: "("},
+				{Type: token.IDENTIFIER, Literal: "x"},
+				{Type: token.RPAREN, Literal: ")"},
+				{Type: token.LBRACE, Literal: "{"},
+				{Type: token.FOR, Literal: "for"},
+				{Type: token.LPAREN, Literal: "("},
+				{Type: token.IDENTIFIER, Literal: "y"},
+				{Type: token.IN, Literal: "in"},
+				{Type: token.IDENTIFIER, Literal: "z"},
+				{Type: token.RPAREN, Literal: ")"},
+				{Type: token.LBRACE, Literal: "{"},
+				{Type: token.BREAK, Literal: "break"},
+				{Type: token.SEMICOLON, Literal: ";"},
+				{Type: token.CONTINUE, Literal: "continue"},
+				{Type: token.RBRACE, Literal: "}"},
+				{Type: token.RBRACE, Literal: "}"},
+			},
+		},
 		{
 			name:  "two char token",
 			input: "10 == 10;\n10 != 9;",
@@ -158,12 +239,117 @@ let result = add(five, ten);
 "hello world"
 "hello\nworld"
 "\"escaped\""
+"\t\r\\"
+"\u{41}\u{1F600}"
 `,
 			expected: []token.Token{
 				{Type: token.STRING, Literal: "foobar"},
 				{Type: token.STRING, Literal: "hello world"},
-				{Type: token.STRING, Literal: "hello\\nworld"},
-				{Type: token.STRING, Literal: `\"escaped\"`},
+				{Type: token.STRING, Literal: "hello\nworld"},
+				{Type: token.STRING, Literal: `"escaped"`},
+				{Type: token.STRING, Literal: "\t\r\\"},
+				{Type: token.STRING, Literal: "A😀"},
+				{Type: token.EOF, Literal: ""},
+			},
+		},
+		{
+			name:  "interpolated string",
+			input: `"hi ${name}, ${ {"a": "${x}"}["a"] }!" "\${x}"`,
+			expected: []token.Token{
+				{Type: token.INTERPHEAD, Literal: "hi "},
+				{Type: token.IDENTIFIER, Literal: "name"},
+				{Type: token.INTERPMIDDLE, Literal: ", "},
+				{Type: token.LBRACE, Literal: "{"},
+				{Type: token.STRING, Literal: "a"},
+				{Type: token.COLON, Literal: ":"},
+				{Type: token.INTERPHEAD, Literal: ""},
+				{Type: token.IDENTIFIER, Literal: "x"},
+				{Type: token.INTERPTAIL, Literal: ""},
+				{Type: token.RBRACE, Literal: "}"},
+				{Type: token.LBRACKET, Literal: "["},
+				{Type: token.STRING, Literal: "a"},
+				{Type: token.RBRACKET, Literal: "]"},
+				{Type: token.INTERPTAIL, Literal: "!"},
+				{Type: token.STRING, Literal: "${x}"},
+				{Type: token.EOF, Literal: ""},
+			},
+		},
+		{
+			name:  "raw string",
+			input: "`C:\\path\n\"line\"` 1",
+			expected: []token.Token{
+				{Type: token.STRING, Literal: "C:\\path\n\"line\""},
+				{Type: token.INTEGER, Literal: "1"},
+				{Type: token.EOF, Literal: ""},
+			},
+		},
+		{
+			name:  "number",
+			input: "10 3.14 1e-9 2E+3 1_000.5 1.x",
+			expected: []token.Token{
+				{Type: token.INTEGER, Literal: "10"},
+				{Type: token.FLOAT, Literal: "3.14"},
+				{Type: token.FLOAT, Literal: "1e-9"},
+				{Type: token.FLOAT, Literal: "2E+3"},
+				{Type: token.FLOAT, Literal: "1_000.5"},
+				{Type: token.INTEGER, Literal: "1"},
+				{Type: token.ILLEGAL, Literal: "."},
+				{Type: token.IDENTIFIER, Literal: "x"},
+				{Type: token.EOF, Literal: ""},
+			},
+		},
+		{
+			name:  "integer with base prefix",
+			input: "0xFF_ff 0o755 0b1010_0101 0XaB 0",
+			expected: []token.Token{
+				{Type: token.INTEGER, Literal: "0xFF_ff"},
+				{Type: token.INTEGER, Literal: "0o755"},
+				{Type: token.INTEGER, Literal: "0b1010_0101"},
+				{Type: token.INTEGER, Literal: "0XaB"},
+				{Type: token.INTEGER, Literal: "0"},
+				{Type: token.EOF, Literal: ""},
+			},
+		},
+		{
+			name:  "unicode",
+			input: `let 이름 = "홍길동"; 이름 == "😀"`,
+			expected: []token.Token{
+				{Type: token.LET, Literal: "let"},
+				{Type: token.IDENTIFIER, Literal: "이름"},
+				{Type: token.ASSIGN, Literal: "="},
+				{Type: token.STRING, Literal: "홍길동"},
+				{Type: token.SEMICOLON, Literal: ";"},
+				{Type: token.IDENTIFIER, Literal: "이름"},
+				{Type: token.EQ, Literal: "=="},
+				{Type: token.STRING, Literal: "😀"},
+				{Type: token.EOF, Literal: ""},
+			},
+		},
+		{
+			name:  "illegal",
+			input: "٣ @",
+			expected: []token.Token{
+				{Type: token.ILLEGAL, Literal: "٣"},
+				{Type: token.ILLEGAL, Literal: "@"},
+				{Type: token.EOF, Literal: ""},
+			},
+		},
+		{
+			name: "comments",
+			input: `// 한 줄 주석
+let x = 1; // 줄 끝 주석
+/* 여러 줄
+   주석 */ x / 2 /**/
+`,
+			expected: []token.Token{
+				{Type: token.LET, Literal: "let"},
+				{Type: token.IDENTIFIER, Literal: "x"},
+				{Type: token.ASSIGN, Literal: "="},
+				{Type: token.INTEGER, Literal: "1"},
+				{Type: token.SEMICOLON, Literal: ";"},
+				{Type: token.IDENTIFIER, Literal: "x"},
+				{Type: token.SLASH, Literal: "/"},
+				{Type: token.INTEGER, Literal: "2"},
 				{Type: token.EOF, Literal: ""},
 			},
 		},
@@ -194,3 +380,216 @@ let result = add(five, ten);
 		})
 	}
 }
+
+func TestLexer_Position(t *testing.T) {
+	t.Parallel()
+
+	input := `let x = 5;
+  x + "ab";
+`
+	expected := []struct {
+		literal string
+		pos     token.Position
+		end     token.Position
+	}{
+		{literal: "let", pos: token.Position{Offset: 0, Line: 1, Column: 1}, end: token.Position{Offset: 3, Line: 1, Column: 4}},
+		{literal: "x", pos: token.Position{Offset: 4, Line: 1, Column: 5}, end: token.Position{Offset: 5, Line: 1, Column: 6}},
+		{literal: "=", pos: token.Position{Offset: 6, Line: 1, Column: 7}, end: token.Position{Offset: 7, Line: 1, Column: 8}},
+		{literal: "5", pos: token.Position{Offset: 8, Line: 1, Column: 9}, end: token.Position{Offset: 9, Line: 1, Column: 10}},
+		{literal: ";", pos: token.Position{Offset: 9, Line: 1, Column: 10}, end: token.Position{Offset: 10, Line: 1, Column: 11}},
+		{literal: "x", pos: token.Position{Offset: 13, Line: 2, Column: 3}, end: token.Position{Offset: 14, Line: 2, Column: 4}},
+		{literal: "+", pos: token.Position{Offset: 15, Line: 2, Column: 5}, end: token.Position{Offset: 16, Line: 2, Column: 6}},
+		{literal: "ab", pos: token.Position{Offset: 17, Line: 2, Column: 7}, end: token.Position{Offset: 21, Line: 2, Column: 11}},
+		{literal: ";", pos: token.Position{Offset: 21, Line: 2, Column: 11}, end: token.Position{Offset: 22, Line: 2, Column: 12}},
+		{literal: "", pos: token.Position{Offset: 23, Line: 3, Column: 1}, end: token.Position{Offset: 23, Line: 3, Column: 1}},
+	}
+
+	lexer := New(input)
+	for i, e := range expected {
+		tok := lexer.NextToken()
+		require.Equalf(t, e.literal, tok.Literal, "input[%d] mismatched", i)
+		require.Equalf(t, e.pos, tok.Pos, "input[%d] mismatched", i)
+		require.Equalf(t, e.end, tok.End, "input[%d] mismatched", i)
+	}
+}
+
+func TestLexer_KeepComments(t *testing.T) {
+	t.Parallel()
+
+	input := `// head
+x /* inline */ + 1 // tail`
+	expected := []struct {
+		typ     token.Type
+		literal string
+		pos     string
+	}{
+		{typ: token.COMMENT, literal: "// head", pos: "1:1"},
+		{typ: token.IDENTIFIER, literal: "x", pos: "2:1"},
+		{typ: token.COMMENT, literal: "/* inline */", pos: "2:3"},
+		{typ: token.PLUS, literal: "+", pos: "2:16"},
+		{typ: token.INTEGER, literal: "1", pos: "2:18"},
+		{typ: token.COMMENT, literal: "// tail", pos: "2:20"},
+		{typ: token.EOF, literal: "", pos: "2:27"},
+	}
+
+	lexer := New(input)
+	lexer.KeepComments = true
+	for i, e := range expected {
+		tok := lexer.NextToken()
+		require.Equalf(t, e.typ, tok.Type, "input[%d] mismatched", i)
+		require.Equalf(t, e.literal, tok.Literal, "input[%d] mismatched", i)
+		require.Equalf(t, e.pos, tok.Pos.String(), "input[%d] mismatched", i)
+	}
+}
+
+func TestLexer_UnicodePosition(t *testing.T) {
+	t.Parallel()
+
+	// 열은 글자 단위, 오프셋은 바이트 단위로 계산함
+	lexer := New("이름 + 1")
+	expected := []token.Position{
+		{Offset: 0, Line: 1, Column: 1},
+		{Offset: 7, Line: 1, Column: 4},
+		{Offset: 9, Line: 1, Column: 6},
+		{Offset: 10, Line: 1, Column: 7},
+	}
+	for i, e := range expected {
+		tok := lexer.NextToken()
+		require.Equalf(t, e, tok.Pos, "input[%d] mismatched", i)
+	}
+}
+
+func TestNewReader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("file name", func(t *testing.T) {
+		t.Parallel()
+
+		// 한 번에 한 바이트씩만 읽히는 입력에서도 같은 토큰을 만들어야 함
+		r := iotest.OneByteReader(strings.NewReader("let x = 10;\nx"))
+		lexer := NewReader(r, "main.monkey")
+
+		expected := []struct {
+			typ token.Type
+			pos string
+		}{
+			{typ: token.LET, pos: "main.monkey:1:1"},
+			{typ: token.IDENTIFIER, pos: "main.monkey:1:5"},
+			{typ: token.ASSIGN, pos: "main.monkey:1:7"},
+			{typ: token.INTEGER, pos: "main.monkey:1:9"},
+			{typ: token.SEMICOLON, pos: "main.monkey:1:11"},
+			{typ: token.IDENTIFIER, pos: "main.monkey:2:1"},
+			{typ: token.EOF, pos: "main.monkey:2:2"},
+		}
+		for i, e := range expected {
+			tok := lexer.NextToken()
+			require.Equalf(t, e.typ, tok.Type, "input[%d] mismatched", i)
+			require.Equalf(t, e.pos, tok.Pos.String(), "input[%d] mismatched", i)
+		}
+		require.NoError(t, lexer.Err())
+	})
+	t.Run("read error", func(t *testing.T) {
+		t.Parallel()
+
+		r := io.MultiReader(strings.NewReader("let"), iotest.ErrReader(errors.New("broken pipe")))
+		lexer := NewReader(r, "pipe")
+
+		require.Equal(t, token.Type(token.LET), lexer.NextToken().Type)
+		require.Equal(t, token.Type(token.EOF), lexer.NextToken().Type)
+		require.EqualError(t, lexer.Err(), "broken pipe")
+	})
+}
+
+func TestLexer_Errors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    string
+		expected []token.Type
+		errs     []string
+	}{
+		{
+			name:     "illegal character",
+			input:    "1 @ 2",
+			expected: []token.Type{token.INTEGER, token.ILLEGAL, token.INTEGER, token.EOF},
+			errs:     []string{"1:3: illegal character '@'"},
+		},
+		{
+			name:     "single ampersand and pipe",
+			input:    "a & b | c",
+			expected: []token.Type{token.IDENTIFIER, token.ILLEGAL, token.IDENTIFIER, token.ILLEGAL, token.IDENTIFIER, token.EOF},
+			errs:     []string{"1:3: illegal character '&'", "1:7: illegal character '|'"},
+		},
+		{
+			name:     "dots",
+			input:    ". .. ...",
+			expected: []token.Type{token.ILLEGAL, token.ILLEGAL, token.ELLIPSIS, token.EOF},
+			errs:     []string{"1:1: illegal character '.'", `1:3: illegal character ".."`},
+		},
+		{
+			name:     "unterminated string",
+			input:    "\"abc\n1",
+			expected: []token.Type{token.ILLEGAL, token.INTEGER, token.EOF},
+			errs:     []string{"1:1: unterminated string literal"},
+		},
+		{
+			name:     "unterminated string at eof",
+			input:    `x = "abc\"`,
+			expected: []token.Type{token.IDENTIFIER, token.ASSIGN, token.ILLEGAL, token.EOF},
+			errs:     []string{"1:5: unterminated string literal"},
+		},
+		{
+			name:     "unterminated interpolation",
+			input:    `x = "a${1`,
+			expected: []token.Type{token.IDENTIFIER, token.ASSIGN, token.INTERPHEAD, token.INTEGER, token.ILLEGAL, token.EOF},
+			errs:     []string{"1:5: unterminated string interpolation"},
+		},
+		{
+			name:     "unterminated interpolation with braces",
+			input:    `"a${ {1} `,
+			expected: []token.Type{token.INTERPHEAD, token.LBRACE, token.INTEGER, token.RBRACE, token.ILLEGAL, token.EOF},
+			errs:     []string{"1:1: unterminated string interpolation"},
+		},
+		{
+			name:     "unterminated raw string",
+			input:    "`abc\ndef",
+			expected: []token.Type{token.ILLEGAL, token.EOF},
+			errs:     []string{"1:1: unterminated raw string literal"},
+		},
+		{
+			name:     "unterminated block comment",
+			input:    "1 /* abc",
+			expected: []token.Type{token.INTEGER, token.ILLEGAL, token.EOF},
+			errs:     []string{"1:3: unterminated block comment"},
+		},
+		{
+			name:     "invalid escape sequences",
+			input:    `"\q\u41\u{110000}\u{zz}" 1`,
+			expected: []token.Type{token.STRING, token.INTEGER, token.EOF},
+			errs: []string{
+				`1:2: unknown escape sequence: \q`,
+				`1:4: invalid unicode escape sequence: missing '{'`,
+				`1:8: invalid unicode code point: "110000"`,
+				`1:18: invalid unicode escape sequence: missing '}'`,
+			},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			lexer := New(tc.input)
+			for i, expected := range tc.expected {
+				require.Equalf(t, expected, lexer.NextToken().Type, "input[%d] mismatched", i)
+			}
+
+			errs := make([]string, 0, len(tc.errs))
+			for _, err := range lexer.Errs.WrappedErrors() {
+				errs = append(errs, err.Error())
+			}
+			require.Equal(t, tc.errs, errs)
+		})
+	}
+}
@@ -1,20 +1,173 @@
 package main
 
 import (
+	"bytes"
+	"flag"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"runtime"
+	"strings"
 	"time"
 
+	"go-interpreter/diagnostic"
+	"go-interpreter/evaluator"
+	"go-interpreter/lexer"
+	"go-interpreter/object"
+	"go-interpreter/parser"
 	"go-interpreter/repl"
 )
 
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+const usage = `Usage:
+  main                          start the REPL
+  main [flags] <file> [args...] run the script file ("-" reads from stdin)
+  main [flags] -e <expr> [args...]
+
+Flags:
+`
+
 func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run 함수는 명령행 인자를 해석해 스크립트나 REPL을 실행하고 종료 코드를 반환함
+func run(argv []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("main", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	expr := flags.String("e", "", "evaluate the given source and print the result")
+	asJSON := flags.Bool("json", false, "print diagnostics as JSON to stderr")
+	flags.Usage = func() {
+		_, _ = fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(argv); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	var isExpr bool
+	flags.Visit(func(f *flag.Flag) {
+		isExpr = isExpr || f.Name == "e"
+	})
+	args := flags.Args()
+
+	s := &script{stdout: stdout, stderr: stderr, asJSON: *asJSON}
+	switch {
+	case isExpr:
+		s.in = strings.NewReader(*expr)
+		s.printResult = true
+	case len(args) == 0:
+		startREPL(stdin, stdout)
+		return exitOK
+	default:
+		name := args[0]
+		args = args[1:]
+		if name == "-" {
+			s.in = stdin
+		} else {
+			f, err := os.Open(name)
+			if err != nil {
+				_, _ = fmt.Fprintf(stderr, "failed to read script: %s\n", err)
+				return exitError
+			}
+			defer func() {
+				_ = f.Close()
+			}()
+			s.filename = name
+			s.in = f
+		}
+	}
+	return s.run(args)
+}
+
+func startREPL(in io.Reader, out io.Writer) {
 	kst, err := time.LoadLocation("Asia/Seoul")
 	if err != nil {
 		panic(err)
 	}
 	now := time.Now().In(kst).Format("2006-01-02 15:04:05")
-	_, _ = fmt.Printf("Unnamed Programming Language (main, %s) on %s(%s)\n", now, runtime.GOOS, runtime.GOARCH)
-	repl.Start(os.Stdin, os.Stdout)
+	_, _ = fmt.Fprintf(out, "Unnamed Programming Language (main, %s) on %s(%s)\n", now, runtime.GOOS, runtime.GOARCH)
+
+	if in == os.Stdin && out == os.Stdout && isTerminal(os.Stdin) && isTerminal(os.Stdout) {
+		if err := repl.StartTerminal(historyFile()); err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, err)
+		}
+		return
+	}
+	repl.Start(in, out)
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// historyFile 함수는 REPL 입력 기록을 저장할 파일 경로를 반환하며
+// 홈 디렉터리를 알 수 없으면 기록을 저장하지 않도록 빈 문자열을 반환함
+func historyFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".go_interpreter_history")
+}
+
+// script 는 파일이나 -e 플래그로 주어진 소스 코드를 한 번에 실행함
+type script struct {
+	filename string
+	in       io.Reader
+	// 진단을 출력할 때 문제가 발생한 줄을 보여주기 위해 렉서가 읽은 소스 코드를 남겨둠
+	src bytes.Buffer
+	// -e 플래그로 실행할 땐 마지막으로 평가한 값을 출력함
+	printResult bool
+
+	stdout io.Writer
+	stderr io.Writer
+	asJSON bool
+}
+
+func (s *script) run(args []string) int {
+	// 소스 코드를 한 번에 읽지 않고 렉서가 필요한 만큼 읽어가도록 넘김
+	p := parser.New(lexer.NewReader(io.TeeReader(s.in, &s.src), s.filename))
+	program := p.ParseProgram()
+	if err := p.Errs.ErrorOrNil(); err != nil {
+		s.report(diagnostic.From(err))
+		return exitError
+	}
+
+	env := object.NewEnvironment()
+	elems := make([]object.Object, len(args))
+	for i, arg := range args {
+		elems[i] = &object.String{Value: arg}
+	}
+	env.Set("args", &object.Array{Elements: elems})
+
+	evaluated := evaluator.Eval(program, env)
+	if err, ok := evaluated.(*object.Error); ok {
+		s.report([]*diagnostic.Diagnostic{err.Diagnostic()})
+		return exitError
+	}
+	if s.printResult && evaluated != nil {
+		_, _ = fmt.Fprintf(s.stdout, "%s\n", evaluated)
+	}
+	return exitOK
+}
+
+func (s *script) report(diags []*diagnostic.Diagnostic) {
+	if s.asJSON {
+		_ = diagnostic.WriteJSON(s.stderr, diags)
+		return
+	}
+	for _, d := range diags {
+		_ = diagnostic.Render(s.stderr, d, s.src.String())
+	}
 }
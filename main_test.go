@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "main.monkey")
+	require.NoError(t, os.WriteFile(file, []byte("let x = 1;\nx + args[0]"), 0o600))
+
+	cases := []struct {
+		name   string
+		argv   []string
+		stdin  string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{
+			name:   "expression",
+			argv:   []string{"-e", "1 + 2"},
+			code:   exitOK,
+			stdout: "3\n",
+		},
+		{
+			name:   "expression with args",
+			argv:   []string{"-e", "args", "a", "b"},
+			code:   exitOK,
+			stdout: "[a, b]\n",
+		},
+		{
+			name:   "script file ending in runtime error",
+			argv:   []string{file, "1"},
+			code:   exitError,
+			stderr: "error[runtime-error]: unsupported operator: 'int' + 'string'\n --> " + file + ":2:1",
+		},
+		{
+			name:  "script from stdin",
+			argv:  []string{"-", "1"},
+			stdin: "args[0]",
+			code:  exitOK,
+		},
+		{
+			name:   "script from stdin ending in runtime error",
+			argv:   []string{"-"},
+			stdin:  "1;\n1 + true",
+			code:   exitError,
+			stderr: "2 | 1 + true\n  | ^^^^^^^^\n",
+		},
+		{
+			name:   "parse error",
+			argv:   []string{"-e", "let = 1"},
+			code:   exitError,
+			stderr: "error[unexpected-token]: expected: IDENTIFIER, but got: =",
+		},
+		{
+			name:   "parse error as json",
+			argv:   []string{"-json", "-e", "let = 1"},
+			code:   exitError,
+			stderr: `[{"severity":"error","code":"unexpected-token"`,
+		},
+		{
+			name:   "missing file",
+			argv:   []string{filepath.Join(dir, "missing.monkey")},
+			code:   exitError,
+			stderr: "failed to read script",
+		},
+		{
+			name: "unknown flag",
+			argv: []string{"-x"},
+			code: exitUsage,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tc.argv, strings.NewReader(tc.stdin), &stdout, &stderr)
+			assert.Equal(t, tc.code, code)
+			assert.Equal(t, tc.stdout, stdout.String())
+			assert.Contains(t, stderr.String(), tc.stderr)
+		})
+	}
+}
@@ -1,9 +1,13 @@
 package object
 
+import "sort"
+
 type Environment struct {
 	env map[string]Object
 	// 함수 안에서 참조할 바깥 환경
 	outer *Environment
+	// true면 이미 정의된 이름이 아닌 이름은 바깥 환경에 정의함
+	transparent bool
 }
 
 func NewEnvironment() *Environment {
@@ -22,12 +26,60 @@ func (e *Environment) Get(name string) (Object, bool) {
 }
 
 func (e *Environment) Set(name string, v Object) Object {
+	if _, ok := e.env[name]; !ok && e.transparent {
+		return e.outer.Set(name, v)
+	}
 	e.env[name] = v
 	return v
 }
 
+// Assign 메서드는 바깥 환경으로 거슬러 올라가며 이미 정의된 이름을 찾아 값을 바꿈
+// 어디에도 정의되지 않은 이름이라면 false를 반환함
+func (e *Environment) Assign(name string, v Object) bool {
+	for env := e; env != nil; env = env.outer {
+		if _, ok := env.env[name]; ok {
+			env.env[name] = v
+			return true
+		}
+	}
+	return false
+}
+
 func (e *Environment) Extend() *Environment {
 	env := NewEnvironment()
 	env.outer = e
 	return env
 }
+
+// ExtendWith 메서드는 name 하나만 정의된 새 환경을 만듦
+// 그 밖의 이름은 바깥 환경에 정의되므로 블록이 새 유효 범위를 만들지 않는 규칙을 지키면서
+// for 문의 반복 변수만 반복마다 따로 둘 수 있음
+func (e *Environment) ExtendWith(name string, v Object) *Environment {
+	env := e.Extend()
+	env.env[name] = v
+	env.transparent = true
+	return env
+}
+
+// Copy 메서드는 현재 환경과 바깥 환경에 정의된 이름을 모두 하나의 새 환경으로 복사함
+// 값은 복사하지 않으므로 배열이나 해시를 그 자리에서 바꾸면 원래 환경에도 반영됨
+func (e *Environment) Copy() *Environment {
+	env := NewEnvironment()
+	if e.outer != nil {
+		env = e.outer.Copy()
+	}
+	for name, v := range e.env {
+		env.env[name] = v
+	}
+	return env
+}
+
+// Names 메서드는 바깥 환경을 제외한 현재 환경에 정의된 이름을 정렬해 반환함
+func (e *Environment) Names() []string {
+	names := make([]string, 0, len(e.env))
+	for name := range e.env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
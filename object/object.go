@@ -3,23 +3,30 @@ package object
 import (
 	"fmt"
 	"hash/fnv"
+	"math"
+	"math/big"
 	"sort"
 	"strconv"
 	"strings"
 
 	"go-interpreter/ast"
+	"go-interpreter/diagnostic"
 )
 
 type Type string
 
 const (
 	IntegerObject     Type = "int"
+	FloatObject       Type = "float"
 	BooleanObject     Type = "bool"
 	StringObject      Type = "string"
 	ArrayObject       Type = "array"
 	HashObject        Type = "hash"
+	RangeObject       Type = "range"
 	NullObject        Type = "null"
 	ReturnValueObject Type = "return value"
+	BreakObject       Type = "break"
+	ContinueObject    Type = "continue"
 	ErrorObject       Type = "error"
 	FunctionObject    Type = "function"
 	BuiltinObject     Type = "builtin"
@@ -58,6 +65,69 @@ func (i *Integer) HashKey() HashKey {
 	}
 }
 
+// BigInteger 는 int64 범위를 넘는 정수이며 타입은 Integer와 같은 int 로 나타냄
+// 같은 값을 항상 같은 객체로 나타내도록 int64 범위 안의 값은 Integer로만 나타냄
+type BigInteger struct {
+	Value *big.Int
+}
+
+// NewInteger 함수는 int64 범위 안의 값이라면 Integer를, 아니라면 BigInteger를 만듦
+func NewInteger(v *big.Int) Object {
+	if v.IsInt64() {
+		return &Integer{Value: v.Int64()}
+	}
+	return &BigInteger{Value: v}
+}
+
+func (i *BigInteger) Type() Type {
+	return IntegerObject
+}
+
+func (i *BigInteger) String() string {
+	return i.Value.String()
+}
+
+// BigInteger는 int64 범위 안의 값과 같을 수 없으므로 Integer와 겹치지 않는 키를 사용함
+func (i *BigInteger) HashKey() HashKey {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(i.Value.String()))
+	return HashKey{
+		Type:  bigIntegerKey,
+		Value: h.Sum64(),
+	}
+}
+
+const bigIntegerKey Type = "bigint"
+
+type Float struct {
+	Value float64
+}
+
+func (f *Float) Type() Type {
+	return FloatObject
+}
+
+func (f *Float) String() string {
+	s := strconv.FormatFloat(f.Value, 'g', -1, 64)
+	// 3.0 처럼 정수로 떨어지는 실수를 정수와 구분하기 위해 소수점을 붙임
+	if !strings.ContainsAny(s, ".eIN") {
+		s += ".0"
+	}
+	return s
+}
+
+func (f *Float) HashKey() HashKey {
+	// 1 == 1.0 이므로 정수로 떨어지는 실수는 정수와 같은 키를 가짐
+	if f.Value == math.Trunc(f.Value) && !math.IsInf(f.Value, 0) {
+		i, _ := big.NewFloat(f.Value).Int(nil)
+		return NewInteger(i).(Hashable).HashKey()
+	}
+	return HashKey{
+		Type:  f.Type(),
+		Value: math.Float64bits(f.Value),
+	}
+}
+
 type Boolean struct {
 	Value bool
 }
@@ -140,6 +210,40 @@ func (h *Hash) String() string {
 	return fmt.Sprintf("{%s}", strings.Join(pairs, ", "))
 }
 
+// Range 는 range() 내장 함수가 반환하는 정수 범위로
+// Start부터 Step씩 더해 Stop에 이르기 전까지의 정수를 나타냄
+type Range struct {
+	Start int64
+	Stop  int64
+	Step  int64
+}
+
+func (r *Range) Type() Type {
+	return RangeObject
+}
+
+func (r *Range) String() string {
+	if r.Step == 1 {
+		return fmt.Sprintf("range(%d, %d)", r.Start, r.Stop)
+	}
+	return fmt.Sprintf("range(%d, %d, %d)", r.Start, r.Stop, r.Step)
+}
+
+// Len 메서드는 범위에 속한 정수의 개수를 반환함
+// range(MinInt64, MaxInt64) 처럼 개수가 int64 범위를 넘을 수 있으므로 uint64로 계산함
+// 두 끝의 차이는 int64에서 넘치더라도 uint64로 바꾸면 올바른 값이 됨
+func (r *Range) Len() uint64 {
+	switch {
+	case r.Step > 0 && r.Start < r.Stop:
+		return uint64(r.Stop-r.Start-1)/uint64(r.Step) + 1
+	case r.Step < 0 && r.Start > r.Stop:
+		// -uint64(r.Step) 는 r.Step 이 MinInt64 여도 절댓값이 됨
+		return uint64(r.Start-r.Stop-1)/-uint64(r.Step) + 1
+	default:
+		return 0
+	}
+}
+
 type Null struct{}
 
 func (n *Null) Type() Type {
@@ -162,9 +266,33 @@ func (v *ReturnValue) String() string {
 	return v.Value.String()
 }
 
+// Break 는 break 명령문을 평가했을 때 반복문까지 전달되어 반복을 멈추게 하는 신호
+type Break struct{}
+
+func (b *Break) Type() Type {
+	return BreakObject
+}
+
+func (b *Break) String() string {
+	return "break"
+}
+
+// Continue 는 continue 명령문을 평가했을 때 반복문까지 전달되어 다음 반복으로 건너뛰게 하는 신호
+type Continue struct{}
+
+func (c *Continue) Type() Type {
+	return ContinueObject
+}
+
+func (c *Continue) String() string {
+	return "continue"
+}
+
 type Error struct {
-	// TODO: 렉서에 행과 열 추적기를 붙인 후 스택트레이스 추가
+	// TODO: 스택트레이스 추가
 	Message string
+	// 에러가 발생한 노드의 범위
+	Span ast.Span
 }
 
 func (e *Error) Type() Type {
@@ -175,10 +303,19 @@ func (e *Error) String() string {
 	return "Error: " + e.Message
 }
 
+// Diagnostic 메서드는 에러를 소스 코드의 위치를 가리키는 진단으로 변환함
+func (e *Error) Diagnostic() *diagnostic.Diagnostic {
+	return diagnostic.Errorf(diagnostic.RuntimeError, e.Span.Start, e.Span.Stop, "%s", e.Message)
+}
+
 type Function struct {
-	Params []*ast.Identifier
-	Body   *ast.BlockStatement
-	Env    *Environment
+	Params   []*ast.Identifier
+	Defaults []ast.Expression
+	Rest     *ast.Identifier
+	Body     *ast.BlockStatement
+	Env      *Environment
+	// 익명 함수라면 비어 있음
+	Name string
 }
 
 func (f *Function) Type() Type {
@@ -186,22 +323,23 @@ func (f *Function) Type() Type {
 }
 
 func (f *Function) String() string {
-	params := make([]string, len(f.Params))
-	for i, p := range f.Params {
-		params[i] = p.String()
-	}
-
 	return fmt.Sprintf(
 		"fn(%s) {\n%s\n}",
-		strings.Join(params, ", "),
+		ast.FormatParams(f.Params, f.Defaults, f.Rest),
 		f.Body,
 	)
 }
 
 type BuiltinFunc func(args ...Object) Object
 
+// KeywordBuiltinFunc 는 키워드 인자를 이름별로 함께 받는 내장 함수
+type KeywordBuiltinFunc func(kwargs map[string]Object, args ...Object) Object
+
 type Builtin struct {
-	Fn BuiltinFunc
+	Name string
+	Fn   BuiltinFunc
+	// 키워드 인자를 받는 내장 함수라면 Fn 대신 KeywordFn을 정의함
+	KeywordFn KeywordBuiltinFunc
 }
 
 func (b *Builtin) Type() Type {
@@ -1,6 +1,8 @@
 package object
 
 import (
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,6 +27,24 @@ func TestHashKey(t *testing.T) {
 		assert.Equal(t, c.HashKey(), d.HashKey())
 		assert.NotEqual(t, a.HashKey(), c.HashKey())
 	})
+	t.Run("float", func(t *testing.T) {
+		a := &Float{Value: 1.5}
+		b := &Float{Value: 1.5}
+		assert.Equal(t, a.HashKey(), b.HashKey())
+		c := &Float{Value: 2.5}
+		assert.NotEqual(t, a.HashKey(), c.HashKey())
+		// 1 == 1.0 이므로 같은 키를 가져야 함
+		assert.Equal(t, (&Integer{Value: 1}).HashKey(), (&Float{Value: 1}).HashKey())
+		big20, _ := new(big.Int).SetString("100000000000000000000", 10)
+		assert.Equal(t, (&BigInteger{Value: big20}).HashKey(), (&Float{Value: 1e20}).HashKey())
+	})
+	t.Run("big int", func(t *testing.T) {
+		a, _ := new(big.Int).SetString("99999999999999999999", 10)
+		b, _ := new(big.Int).SetString("99999999999999999999", 10)
+		assert.Equal(t, (&BigInteger{Value: a}).HashKey(), (&BigInteger{Value: b}).HashKey())
+		c := new(big.Int).Add(a, big.NewInt(1))
+		assert.NotEqual(t, (&BigInteger{Value: a}).HashKey(), (&BigInteger{Value: c}).HashKey())
+	})
 	t.Run("bool", func(t *testing.T) {
 		a := &Boolean{Value: false}
 		b := &Boolean{Value: false}
@@ -35,3 +55,111 @@ func TestHashKey(t *testing.T) {
 		assert.NotEqual(t, a.HashKey(), c.HashKey())
 	})
 }
+
+func TestNewInteger(t *testing.T) {
+	assert.Equal(t, &Integer{Value: math.MaxInt64}, NewInteger(big.NewInt(math.MaxInt64)))
+	assert.Equal(t, &Integer{Value: math.MinInt64}, NewInteger(big.NewInt(math.MinInt64)))
+
+	v := new(big.Int).Add(big.NewInt(math.MaxInt64), big.NewInt(1))
+	assert.Equal(t, &BigInteger{Value: v}, NewInteger(v))
+}
+
+func TestFloat_String(t *testing.T) {
+	cases := []struct {
+		value    float64
+		expected string
+	}{
+		{value: 3.14, expected: "3.14"},
+		{value: 3, expected: "3.0"},
+		{value: -0.5, expected: "-0.5"},
+		{value: 1e21, expected: "1e+21"},
+		{value: math.Inf(1), expected: "+Inf"},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, (&Float{Value: tc.value}).String())
+	}
+}
+
+func TestRange_Len(t *testing.T) {
+	cases := []struct {
+		r        Range
+		expected uint64
+	}{
+		{r: Range{Start: 0, Stop: 5, Step: 1}, expected: 5},
+		{r: Range{Start: 0, Stop: 5, Step: 2}, expected: 3},
+		{r: Range{Start: 5, Stop: 0, Step: -2}, expected: 3},
+		{r: Range{Start: 5, Stop: 0, Step: 1}, expected: 0},
+		{r: Range{Start: 0, Stop: 5, Step: -1}, expected: 0},
+		{r: Range{Start: 3, Stop: 3, Step: 1}, expected: 0},
+		// 두 끝의 차이나 개수가 int64 범위를 넘는 범위
+		{r: Range{Start: 0, Stop: math.MaxInt64, Step: 10}, expected: 922337203685477581},
+		{r: Range{Start: math.MinInt64, Stop: math.MaxInt64, Step: 1}, expected: math.MaxUint64},
+		{r: Range{Start: math.MaxInt64, Stop: math.MinInt64, Step: -1}, expected: math.MaxUint64},
+		{r: Range{Start: math.MaxInt64, Stop: math.MinInt64, Step: math.MinInt64}, expected: 2},
+		{r: Range{Start: math.MinInt64, Stop: math.MaxInt64, Step: math.MaxInt64}, expected: 3},
+		{r: Range{Start: 0, Stop: -1, Step: math.MinInt64}, expected: 1},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, tc.r.Len(), tc.r.String())
+	}
+}
+
+func TestEnvironment_Assign(t *testing.T) {
+	outer := NewEnvironment()
+	outer.Set("x", &Integer{Value: 1})
+	inner := outer.Extend()
+	inner.Set("y", &Integer{Value: 2})
+
+	assert.True(t, inner.Assign("x", &Integer{Value: 10}))
+	assert.True(t, inner.Assign("y", &Integer{Value: 20}))
+	assert.False(t, inner.Assign("z", &Integer{Value: 30}))
+
+	x, _ := outer.Get("x")
+	assert.Equal(t, &Integer{Value: 10}, x)
+	_, ok := outer.Get("y")
+	assert.False(t, ok)
+	_, ok = inner.Get("z")
+	assert.False(t, ok)
+}
+
+func TestEnvironment_ExtendWith(t *testing.T) {
+	outer := NewEnvironment()
+	inner := outer.ExtendWith("x", &Integer{Value: 1})
+	inner.Set("x", &Integer{Value: 2})
+	inner.Set("y", &Integer{Value: 3})
+
+	x, _ := inner.Get("x")
+	assert.Equal(t, &Integer{Value: 2}, x)
+	assert.Equal(t, []string{"x"}, inner.Names())
+	// 반복 변수가 아닌 이름은 바깥 환경에 정의됨
+	assert.Equal(t, []string{"y"}, outer.Names())
+}
+
+func TestEnvironment_Copy(t *testing.T) {
+	outer := NewEnvironment()
+	outer.Set("x", &Integer{Value: 1})
+	outer.Set("y", &Integer{Value: 2})
+	inner := outer.Extend()
+	inner.Set("y", &Integer{Value: 20})
+
+	env := inner.Copy()
+	assert.Equal(t, []string{"x", "y"}, env.Names())
+	y, _ := env.Get("y")
+	assert.Equal(t, &Integer{Value: 20}, y)
+
+	// 복사한 환경에서 바꾼 이름은 원래 환경에 반영되지 않음
+	assert.True(t, env.Assign("x", &Integer{Value: 10}))
+	x, _ := outer.Get("x")
+	assert.Equal(t, &Integer{Value: 1}, x)
+}
+
+func TestEnvironment_Names(t *testing.T) {
+	env := NewEnvironment()
+	env.Set("b", &Integer{Value: 1})
+	env.Set("a", &Integer{Value: 2})
+	inner := env.Extend()
+	inner.Set("c", &Integer{Value: 3})
+
+	assert.Equal(t, []string{"a", "b"}, env.Names())
+	assert.Equal(t, []string{"c"}, inner.Names())
+}
@@ -2,12 +2,15 @@ package parser
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
 
 	"go-interpreter/ast"
+	"go-interpreter/diagnostic"
 	"go-interpreter/lexer"
 	"go-interpreter/token"
 )
@@ -32,6 +35,13 @@ type Parser struct {
 	// 현재 토큰 토큰에 따라 사용할 수 있는 파싱 함수
 	prefixParseFnMap map[token.Type]prefixParseFn
 	infixParseFnMap  map[token.Type]infixParseFn
+
+	// currToken까지 열고 닫히지 않은 { 의 개수
+	// 에러 복구 시 같은 블록 안에서 다음 명령문을 찾기 위해 필요함
+	depth int
+	// 파싱 중인 반복문 본문의 중첩 수
+	// break, continue가 반복문 안에 있는지 확인하기 위해 필요함
+	loops int
 }
 
 func New(l *lexer.Lexer) *Parser {
@@ -42,7 +52,9 @@ func New(l *lexer.Lexer) *Parser {
 	p.prefixParseFnMap = map[token.Type]prefixParseFn{
 		token.IDENTIFIER: p.parseIdentifier,
 		token.INTEGER:    p.parseIntegerLiteral,
+		token.FLOAT:      p.parseFloatLiteral,
 		token.STRING:     p.parseStringLiteral,
+		token.INTERPHEAD: p.parseInterpolatedString,
 		token.LBRACKET:   p.parseArrayLiteral,
 		token.LBRACE:     p.parseHashLiteral,
 		token.BANG:       p.parsePrefixExpression,
@@ -52,18 +64,31 @@ func New(l *lexer.Lexer) *Parser {
 		token.LPAREN:     p.parseGroupedExpression,
 		token.IF:         p.parseIfExpression,
 		token.FUNCTION:   p.parseFunctionLiteral,
+		token.ILLEGAL:    p.parseIllegal,
 	}
 	p.infixParseFnMap = map[token.Type]infixParseFn{
 		token.EQ:       p.parseInfixExpression,
 		token.NEQ:      p.parseInfixExpression,
 		token.LT:       p.parseInfixExpression,
 		token.GT:       p.parseInfixExpression,
+		token.AND:      p.parseInfixExpression,
+		token.OR:       p.parseInfixExpression,
 		token.PLUS:     p.parseInfixExpression,
 		token.MINUS:    p.parseInfixExpression,
 		token.ASTERISK: p.parseInfixExpression,
 		token.SLASH:    p.parseInfixExpression,
-		token.LPAREN:   p.parseCallExpression,
-		token.LBRACKET: p.parseIndexExpression,
+		token.PERCENT:  p.parseInfixExpression,
+		token.LTE:      p.parseInfixExpression,
+		token.GTE:      p.parseInfixExpression,
+		// 대입 연산자
+		token.ASSIGN:         p.parseAssignExpression,
+		token.PLUSASSIGN:     p.parseAssignExpression,
+		token.MINUSASSIGN:    p.parseAssignExpression,
+		token.ASTERISKASSIGN: p.parseAssignExpression,
+		token.SLASHASSIGN:    p.parseAssignExpression,
+		token.PERCENTASSIGN:  p.parseAssignExpression,
+		token.LPAREN:         p.parseCallExpression,
+		token.LBRACKET:       p.parseIndexExpression,
 	}
 
 	// currToken, peekToken 세팅
@@ -75,24 +100,55 @@ func New(l *lexer.Lexer) *Parser {
 func (p *Parser) ParseProgram() *ast.Program {
 	program := &ast.Program{}
 	for !p.currentTokenIs(token.EOF) {
-		stmt := p.parseStatement()
-		program.Statements = append(program.Statements, stmt)
+		// 에러가 발생한 명령문은 버림
+		if stmt := p.parseStatement(); stmt != nil {
+			program.Statements = append(program.Statements, stmt)
+		}
 		p.nextToken()
 	}
+	if p.l.Errs != nil {
+		p.Errs = multierror.Append(p.Errs, p.l.Errs)
+	}
+	if err := p.l.Err(); err != nil {
+		p.Errs = multierror.Append(p.Errs, errors.Wrap(err, "failed to read input"))
+	}
+	// 렉싱 에러와 파싱 에러를 소스 코드에 나타난 순서대로 보여줌
+	if p.Errs != nil {
+		diagnostic.Sort(p.Errs.Errors)
+	}
 	return program
 }
 
 func (p *Parser) nextToken() {
 	p.currToken = p.peekToken
 	p.peekToken = p.l.NextToken()
+
+	switch p.currToken.Type {
+	case token.LBRACE:
+		p.depth++
+	case token.RBRACE:
+		// 짝이 맞지 않는 } 는 무시함
+		if p.depth > 0 {
+			p.depth--
+		}
+	}
 }
 
-func (p *Parser) parseStatement() ast.Statement {
+// parseStatement 메서드는 파싱 중 에러가 발생하면 다음 명령문 직전까지 토큰을 건너뛰고 nil을 반환함
+func (p *Parser) parseStatement() (stmt ast.Statement) {
+	defer p.recoverStatement(p.depth, &stmt)
+
 	switch p.currToken.Type {
 	case token.LET:
 		return p.parseLetStatement()
 	case token.RETURN:
 		return p.parseReturnStatement()
+	case token.WHILE:
+		return p.parseWhileStatement()
+	case token.FOR:
+		return p.parseForStatement()
+	case token.BREAK, token.CONTINUE:
+		return p.parseBranchStatement()
 	default:
 		return p.parseExpressionStatement()
 	}
@@ -107,24 +163,25 @@ func (p *Parser) parseLetStatement() *ast.LetStatement {
 		Value: nil,
 	}
 
-	if !p.expectPeek(token.IDENTIFIER) {
-		return nil
-	}
+	p.expectPeek(token.IDENTIFIER)
 
 	stmt.Name = p.parseIdentifier().(*ast.Identifier)
 
-	if !p.expectPeek(token.ASSIGN) {
-		return nil
-	}
+	p.expectPeek(token.ASSIGN)
 
 	// = 토큰을 지나 표현식이 있는 곳으로 진행
 	p.nextToken()
 
 	stmt.Value = p.parseExpression(LOWEST)
+	// 에러 메시지에 함수 이름을 보여줄 수 있도록 선언한 이름을 함수에 기록함
+	if fn, ok := stmt.Value.(*ast.FunctionLiteral); ok {
+		fn.Name = stmt.Name.Value
+	}
 
 	for p.peekTokenIs(token.SEMICOLON) {
 		p.nextToken()
 	}
+	stmt.Span = p.spanFrom(stmt.Token.Pos)
 	return stmt
 }
 
@@ -143,6 +200,7 @@ func (p *Parser) parseReturnStatement() *ast.ReturnStatement {
 	for p.peekTokenIs(token.SEMICOLON) {
 		p.nextToken()
 	}
+	stmt.Span = p.spanFrom(stmt.Token.Pos)
 	return stmt
 }
 
@@ -151,14 +209,16 @@ func (p *Parser) parseExpressionStatement() *ast.ExpressionStatement {
 
 	stmt := &ast.ExpressionStatement{
 		Token:      p.currToken,
-		Expression: p.parseExpression(LOWEST),
+		Expression: nil,
 	}
+	stmt.Expression = p.parseExpression(LOWEST)
 
 	// REPL에서 "5 + 5"같은 표현식을 간편하게 사용하기 위해
 	// 세미콜론을 선택적으로 검사
 	for p.peekTokenIs(token.SEMICOLON) {
 		p.nextToken()
 	}
+	stmt.Span = p.spanFrom(stmt.Token.Pos)
 	return stmt
 }
 
@@ -170,13 +230,20 @@ func (p *Parser) parseBlockStatement() *ast.BlockStatement {
 		Statements: nil,
 	}
 
+	depth := p.depth
 	p.nextToken()
 
 	for !p.currentTokenIs(token.RBRACE) && !p.currentTokenIs(token.EOF) {
-		stmt := p.parseStatement()
-		block.Statements = append(block.Statements, stmt)
+		if stmt := p.parseStatement(); stmt != nil {
+			block.Statements = append(block.Statements, stmt)
+		}
+		// 에러를 복구하며 블록을 닫는 } 까지 건너뛰었다면 블록을 끝냄
+		if p.depth < depth {
+			break
+		}
 		p.nextToken()
 	}
+	block.Span = p.spanFrom(block.Token.Pos)
 	return block
 }
 
@@ -185,8 +252,7 @@ func (p *Parser) parseExpression(precedence opPrecedence) ast.Expression {
 
 	prefix := p.prefixParseFnMap[p.currToken.Type]
 	if prefix == nil {
-		p.Errs = multierror.Append(p.Errs, errors.Errorf("no prefix parse function for %s", p.currToken.Type))
-		return nil
+		p.markAsError(diagnostic.UnexpectedToken, p.currToken, "no prefix parse function for %s", p.currToken.Type)
 	}
 	left := prefix()
 
@@ -208,6 +274,7 @@ func (p *Parser) parseIdentifier() ast.Expression {
 
 	// nextToken()을 호출하지 않음
 	return &ast.Identifier{
+		Span:  p.spanFrom(p.currToken.Pos),
 		Token: p.currToken,
 		Value: p.currToken.Literal,
 	}
@@ -217,31 +284,108 @@ func (p *Parser) parseIntegerLiteral() ast.Expression {
 	defer untrace(trace("정수"))
 
 	// nextToken()을 호출하지 않음
-	i, err := strconv.ParseInt(p.currToken.Literal, 10, 64)
-	if err != nil {
-		p.Errs = multierror.Append(p.Errs, errors.Errorf("could not parse %q as integer", p.currToken.Literal))
-		return nil
+	literal := normalizeInteger(p.currToken.Literal)
+	// 진법을 0으로 주면 접두사로 진법을 판단하고 _ 구분자도 허용함
+	if i, err := strconv.ParseInt(literal, 0, 64); err == nil {
+		return &ast.IntegerLiteral{
+			Span:  p.spanFrom(p.currToken.Pos),
+			Token: p.currToken,
+			Value: i,
+		}
+	}
+	// int64 범위를 넘는 정수는 큰 정수 리터럴로 만듦
+	i, ok := new(big.Int).SetString(literal, 0)
+	if !ok {
+		p.markAsError(diagnostic.InvalidNumber, p.currToken, "could not parse %q as integer", p.currToken.Literal)
 	}
-	return &ast.IntegerLiteral{
+	return &ast.BigIntegerLiteral{
+		Span:  p.spanFrom(p.currToken.Pos),
 		Token: p.currToken,
 		Value: i,
 	}
 }
 
+// normalizeInteger 함수는 0으로 시작하는 10진수가 8진수로 해석되지 않도록 앞의 0을 제거함
+// 0x, 0o, 0b 접두사가 붙은 정수 리터럴은 그대로 반환함
+func normalizeInteger(literal string) string {
+	if len(literal) > 1 && literal[0] == '0' && strings.ContainsRune("xXoObB", rune(literal[1])) {
+		return literal
+	}
+	decimal := strings.TrimLeft(literal, "0")
+	if decimal == "" {
+		decimal = "0"
+	}
+	return decimal
+}
+
+func (p *Parser) parseFloatLiteral() ast.Expression {
+	defer untrace(trace("실수"))
+
+	// nextToken()을 호출하지 않음
+	f, err := strconv.ParseFloat(p.currToken.Literal, 64)
+	if err != nil {
+		p.markAsError(diagnostic.InvalidNumber, p.currToken, "could not parse %q as float", p.currToken.Literal)
+	}
+	return &ast.FloatLiteral{
+		Span:  p.spanFrom(p.currToken.Pos),
+		Token: p.currToken,
+		Value: f,
+	}
+}
+
 func (p *Parser) parseStringLiteral() ast.Expression {
 	defer untrace(trace("문자열"))
 
 	// nextToken()을 호출하지 않음
 	return &ast.StringLiteral{
+		Span:  p.spanFrom(p.currToken.Pos),
 		Token: p.currToken,
 		Value: p.currToken.Literal,
 	}
 }
 
+func (p *Parser) parseInterpolatedString() ast.Expression {
+	defer untrace(trace("보간 문자열"))
+
+	exp := &ast.InterpolatedString{
+		Token:   p.currToken,
+		Strings: []string{p.currToken.Literal},
+		Exprs:   nil,
+	}
+	for {
+		// ${ 를 지나 보간할 표현식으로 진행
+		p.nextToken()
+		exp.Exprs = append(exp.Exprs, p.parseExpression(LOWEST))
+
+		if p.peekTokenIs(token.INTERPTAIL) {
+			p.nextToken()
+			exp.Strings = append(exp.Strings, p.currToken.Literal)
+			break
+		}
+		// 닫히지 않은 보간은 렉서가 이미 에러를 기록했으므로 내부 토큰 이름을 드러내지 않고 중단함
+		if p.peekTokenIs(token.ILLEGAL) {
+			panic(bailout{})
+		}
+		p.expectPeek(token.INTERPMIDDLE)
+		exp.Strings = append(exp.Strings, p.currToken.Literal)
+	}
+	exp.Span = p.spanFrom(exp.Token.Pos)
+	return exp
+}
+
+// parseIllegal 메서드는 렉서가 이미 에러로 기록한 토큰이므로
+// 에러를 다시 저장하지 않고 파싱을 중단함
+func (p *Parser) parseIllegal() ast.Expression {
+	defer untrace(trace("잘못된 토큰"))
+
+	panic(bailout{})
+}
+
 func (p *Parser) parseBoolean() ast.Expression {
 	defer untrace(trace("불리언"))
 
 	return &ast.Boolean{
+		Span:  p.spanFrom(p.currToken.Pos),
 		Token: p.currToken,
 		Value: p.currentTokenIs(token.TRUE),
 	}
@@ -250,10 +394,13 @@ func (p *Parser) parseBoolean() ast.Expression {
 func (p *Parser) parseArrayLiteral() ast.Expression {
 	defer untrace(trace("배열"))
 
-	return &ast.ArrayLiteral{
+	array := &ast.ArrayLiteral{
 		Token:    p.currToken,
-		Elements: p.parseListExpression(token.RBRACKET),
+		Elements: nil,
 	}
+	array.Elements = p.parseListExpression(token.RBRACKET)
+	array.Span = p.spanFrom(array.Token.Pos)
+	return array
 }
 
 func (p *Parser) parseHashLiteral() ast.Expression {
@@ -267,23 +414,20 @@ func (p *Parser) parseHashLiteral() ast.Expression {
 		p.nextToken()
 		k := p.parseExpression(LOWEST)
 
-		if !p.expectPeek(token.COLON) {
-			return nil
-		}
+		p.expectPeek(token.COLON)
 		p.nextToken()
 		v := p.parseExpression(LOWEST)
 
 		hash.Pairs[k] = v
 
 		// 하나의 pair 파싱 후엔 '}'로 끝나거나 ','로 다른 pair 파싱을 이어가야함
-		if !p.peekTokenIs(token.RBRACE) && !p.expectPeek(token.COMMA) {
-			return nil
+		if !p.peekTokenIs(token.RBRACE) {
+			p.expectPeek(token.COMMA)
 		}
 	}
 
-	if !p.expectPeek(token.RBRACE) {
-		return nil
-	}
+	p.expectPeek(token.RBRACE)
+	hash.Span = p.spanFrom(hash.Token.Pos)
 	return hash
 }
 
@@ -300,6 +444,7 @@ func (p *Parser) parsePrefixExpression() ast.Expression {
 	// 토큰을 소모해 다음으로 진행시킴
 	p.nextToken()
 	exp.Right = p.parseExpression(PREFIX)
+	exp.Span = p.spanFrom(exp.Token.Pos)
 	return exp
 }
 
@@ -316,17 +461,89 @@ func (p *Parser) parseInfixExpression(left ast.Expression) ast.Expression {
 	precedence := p.currPrecedence()
 	p.nextToken()
 	exp.Right = p.parseExpression(precedence)
+	exp.Span = p.spanFrom(left.Pos())
+	return exp
+}
+
+// parseAssignExpression 메서드는 x = y, x += y 같은 대입 표현식을 파싱함
+// x = y = 1 이 x = (y = 1) 이 되도록 오른쪽 결합으로 파싱함
+func (p *Parser) parseAssignExpression(target ast.Expression) ast.Expression {
+	defer untrace(trace(fmt.Sprintf("대입 표현식, target: %s", target)))
+
+	exp := &ast.AssignExpression{
+		Token:    p.currToken,
+		Target:   target,
+		Operator: p.currToken.Literal,
+		Value:    nil,
+	}
+	switch target.(type) {
+	case *ast.Identifier, *ast.IndexExpression:
+	default:
+		p.markAsError(diagnostic.InvalidAssignment, p.currToken, "cannot assign to %s", target)
+	}
+
+	p.nextToken()
+	exp.Value = p.parseExpression(ASSIGN - 1)
+	exp.Span = p.spanFrom(target.Pos())
 	return exp
 }
 
 func (p *Parser) parseCallExpression(fn ast.Expression) ast.Expression {
 	defer untrace(trace(fmt.Sprintf("함수 호출 표현식, fn: %s", fn)))
 
-	return &ast.CallExpression{
+	call := &ast.CallExpression{
 		Token:     p.currToken,
 		Function:  fn,
-		Arguments: p.parseListExpression(token.RPAREN),
+		Arguments: nil,
+	}
+	call.Arguments = p.parseCallArguments()
+	call.Span = p.spanFrom(fn.Pos())
+	return call
+}
+
+// parseCallArguments 메서드는 f(1, y: 2) 처럼 위치 인자와 키워드 인자가 섞인 호출 인자를 파싱함
+// 키워드 인자는 위치 인자 뒤에만 올 수 있고 같은 이름을 두 번 쓸 수 없음
+func (p *Parser) parseCallArguments() []ast.Expression {
+	// 빈 인자
+	if p.peekTokenIs(token.RPAREN) {
+		p.nextToken()
+		return nil
+	}
+	p.nextToken()
+
+	args := make([]ast.Expression, 0)
+	names := make(map[string]bool)
+	for {
+		if p.currentTokenIs(token.IDENTIFIER) && p.peekTokenIs(token.COLON) {
+			arg := &ast.KeywordArgument{
+				Token: p.currToken,
+				Name:  p.parseIdentifier().(*ast.Identifier),
+				Value: nil,
+			}
+			if names[arg.Name.Value] {
+				p.markAsError(diagnostic.InvalidArgument, p.currToken, "keyword argument repeated: '%s'", arg.Name.Value)
+			}
+			names[arg.Name.Value] = true
+			p.nextToken() // token.COLON
+			p.nextToken() // 값
+			arg.Value = p.parseExpression(LOWEST)
+			arg.Span = p.spanFrom(arg.Token.Pos)
+			args = append(args, arg)
+		} else {
+			if len(names) > 0 {
+				p.markAsError(diagnostic.InvalidArgument, p.currToken, "positional argument follows keyword argument")
+			}
+			args = append(args, p.parseExpression(LOWEST))
+		}
+		if !p.peekTokenIs(token.COMMA) {
+			break
+		}
+		p.nextToken() // token.COMMA
+		p.nextToken() // 다음 인자
 	}
+
+	p.expectPeek(token.RPAREN)
+	return args
 }
 
 func (p *Parser) parseIndexExpression(left ast.Expression) ast.Expression {
@@ -338,12 +555,44 @@ func (p *Parser) parseIndexExpression(left ast.Expression) ast.Expression {
 		Index: nil,
 	}
 
+	// x[:high] 처럼 시작 인덱스를 생략한 슬라이스
+	if p.peekTokenIs(token.COLON) {
+		p.nextToken()
+		return p.parseSliceExpression(exp.Token, left, nil)
+	}
+
 	p.nextToken()
 	exp.Index = p.parseExpression(LOWEST)
 
-	if !p.expectPeek(token.RBRACKET) {
-		return nil
+	if p.peekTokenIs(token.COLON) {
+		p.nextToken()
+		return p.parseSliceExpression(exp.Token, left, exp.Index)
 	}
+
+	p.expectPeek(token.RBRACKET)
+	exp.Span = p.spanFrom(left.Pos())
+	return exp
+}
+
+// parseSliceExpression 메서드는 token.COLON이 currToken인 상태로 진입함
+func (p *Parser) parseSliceExpression(tok token.Token, left, low ast.Expression) ast.Expression {
+	defer untrace(trace(fmt.Sprintf("슬라이스 표현식, left: %s", left)))
+
+	exp := &ast.SliceExpression{
+		Token: tok,
+		Left:  left,
+		Low:   low,
+		High:  nil,
+	}
+
+	// x[low:] 처럼 끝 인덱스를 생략한 슬라이스
+	if !p.peekTokenIs(token.RBRACKET) {
+		p.nextToken()
+		exp.High = p.parseExpression(LOWEST)
+	}
+
+	p.expectPeek(token.RBRACKET)
+	exp.Span = p.spanFrom(left.Pos())
 	return exp
 }
 
@@ -365,52 +614,122 @@ func (p *Parser) parseListExpression(until token.Type) []ast.Expression {
 		p.nextToken() // 다음 표현식
 	}
 
-	if !p.expectPeek(until) {
-		return nil
-	}
+	p.expectPeek(until)
 	return list
 }
 
 func (p *Parser) parseGroupedExpression() ast.Expression {
 	defer untrace(trace("그룹 표현식"))
 
+	start := p.currToken.Pos
 	p.nextToken()
 	exp := p.parseExpression(LOWEST)
-	if !p.expectPeek(token.RPAREN) {
-		return nil
-	}
+	p.expectPeek(token.RPAREN)
 
+	// 그룹 표현식은 별도의 노드를 만들지 않으므로 안쪽 표현식의 범위를 괄호까지 넓힘
+	if node, ok := exp.(interface{ SetSpan(ast.Span) }); ok {
+		node.SetSpan(p.spanFrom(start))
+	}
 	return exp
 }
 
+func (p *Parser) parseWhileStatement() *ast.WhileStatement {
+	defer untrace(trace("while 반복문"))
+
+	stmt := &ast.WhileStatement{
+		Token: p.currToken,
+	}
+	p.expectPeek(token.LPAREN)
+
+	p.nextToken()
+	stmt.Condition = p.parseExpression(LOWEST)
+	p.expectPeek(token.RPAREN)
+
+	p.expectPeek(token.LBRACE)
+	stmt.Body = p.parseLoopBody()
+
+	for p.peekTokenIs(token.SEMICOLON) {
+		p.nextToken()
+	}
+	stmt.Span = p.spanFrom(stmt.Token.Pos)
+	return stmt
+}
+
+func (p *Parser) parseForStatement() *ast.ForStatement {
+	defer untrace(trace("for 반복문"))
+
+	stmt := &ast.ForStatement{
+		Token: p.currToken,
+	}
+	p.expectPeek(token.LPAREN)
+
+	p.expectPeek(token.IDENTIFIER)
+	stmt.Variable = p.parseIdentifier().(*ast.Identifier)
+	p.expectPeek(token.IN)
+
+	p.nextToken()
+	stmt.Iterable = p.parseExpression(LOWEST)
+	p.expectPeek(token.RPAREN)
+
+	p.expectPeek(token.LBRACE)
+	stmt.Body = p.parseLoopBody()
+
+	for p.peekTokenIs(token.SEMICOLON) {
+		p.nextToken()
+	}
+	stmt.Span = p.spanFrom(stmt.Token.Pos)
+	return stmt
+}
+
+// parseLoopBody 메서드는 break, continue를 쓸 수 있는 반복문의 본문을 파싱함
+func (p *Parser) parseLoopBody() *ast.BlockStatement {
+	p.loops++
+	defer func() {
+		p.loops--
+	}()
+	return p.parseBlockStatement()
+}
+
+// parseBranchStatement 메서드는 반복문 안에 있는 break, continue 명령문을 파싱함
+func (p *Parser) parseBranchStatement() ast.Statement {
+	defer untrace(trace("분기문"))
+
+	tok := p.currToken
+	if p.loops == 0 {
+		p.markAsError(diagnostic.MisplacedStatement, tok, "'%s' outside loop", tok.Literal)
+	}
+
+	for p.peekTokenIs(token.SEMICOLON) {
+		p.nextToken()
+	}
+	span := p.spanFrom(tok.Pos)
+	if tok.Type == token.BREAK {
+		return &ast.BreakStatement{Span: span, Token: tok}
+	}
+	return &ast.ContinueStatement{Span: span, Token: tok}
+}
+
 func (p *Parser) parseIfExpression() ast.Expression {
 	defer untrace(trace("조건 표현식"))
 
 	exp := &ast.IfExpression{
 		Token: p.currToken,
 	}
-	if !p.expectPeek(token.LPAREN) {
-		return nil
-	}
+	p.expectPeek(token.LPAREN)
 
 	p.nextToken()
 	exp.Condition = p.parseExpression(LOWEST)
-	if !p.expectPeek(token.RPAREN) {
-		return nil
-	}
+	p.expectPeek(token.RPAREN)
 
-	if !p.expectPeek(token.LBRACE) {
-		return nil
-	}
+	p.expectPeek(token.LBRACE)
 	exp.Consequence = p.parseBlockStatement()
 
 	if p.peekTokenIs(token.ELSE) {
 		p.nextToken()
-		if !p.expectPeek(token.LBRACE) {
-			return nil
-		}
+		p.expectPeek(token.LBRACE)
 		exp.Alternative = p.parseBlockStatement()
 	}
+	exp.Span = p.spanFrom(exp.Token.Pos)
 	return exp
 }
 
@@ -422,30 +741,63 @@ func (p *Parser) parseFunctionLiteral() ast.Expression {
 		Params: nil,
 		Body:   nil,
 	}
-	if !p.expectPeek(token.LPAREN) {
-		return nil
-	}
+	p.expectPeek(token.LPAREN)
 
-	l.Params = p.parseFunctionParams()
+	p.parseFunctionParams(l)
 
-	if !p.expectPeek(token.LBRACE) {
-		return nil
-	}
+	p.expectPeek(token.LBRACE)
+	// 함수 본문에서는 함수 바깥의 반복문을 멈추거나 건너뛸 수 없음
+	loops := p.loops
+	p.loops = 0
+	defer func() {
+		p.loops = loops
+	}()
 	l.Body = p.parseBlockStatement()
+	l.Span = p.spanFrom(l.Token.Pos)
 	return l
 }
 
-func (p *Parser) parseFunctionParams() []*ast.Identifier {
+// parseFunctionParams 메서드는 x, y = 10, ...rest 형태의 파라미터 목록을 파싱해 l에 채움
+// 기본값은 기본값이 없는 파라미터 뒤에만 올 수 있고 ...rest 는 마지막에만 올 수 있음
+func (p *Parser) parseFunctionParams(l *ast.FunctionLiteral) {
 	// 빈 파라미터
 	if p.peekTokenIs(token.RPAREN) {
 		p.nextToken()
-		return nil
+		return
 	}
 	p.nextToken()
 
-	ids := make([]*ast.Identifier, 0)
+	l.Params = make([]*ast.Identifier, 0)
+	names := make(map[string]bool)
 	for {
-		ids = append(ids, p.parseIdentifier().(*ast.Identifier))
+		if p.currentTokenIs(token.ELLIPSIS) {
+			p.expectPeek(token.IDENTIFIER)
+			l.Rest = p.parseIdentifier().(*ast.Identifier)
+			if names[l.Rest.Value] {
+				p.markAsError(diagnostic.UnexpectedToken, p.currToken, "duplicate parameter name: '%s'", l.Rest.Value)
+			}
+			if p.peekTokenIs(token.COMMA) {
+				p.markAsError(diagnostic.UnexpectedToken, p.peekToken, "rest parameter must be last")
+			}
+			break
+		}
+		if !p.currentTokenIs(token.IDENTIFIER) {
+			p.markAsError(diagnostic.UnexpectedToken, p.currToken, "expected: %s, but got: %s", token.IDENTIFIER, p.currToken.Type)
+		}
+		param := p.parseIdentifier().(*ast.Identifier)
+		if names[param.Value] {
+			p.markAsError(diagnostic.UnexpectedToken, p.currToken, "duplicate parameter name: '%s'", param.Value)
+		}
+		names[param.Value] = true
+		l.Params = append(l.Params, param)
+		if p.peekTokenIs(token.ASSIGN) {
+			p.nextToken() // token.ASSIGN
+			p.nextToken() // 기본값
+			// 기본값 안의 = 를 대입 표현식으로 파싱하지 않도록 대입보다 높은 우선순위로 파싱함
+			l.Defaults = append(l.Defaults, p.parseExpression(ASSIGN))
+		} else if len(l.Defaults) > 0 {
+			p.markAsError(diagnostic.UnexpectedToken, p.currToken, "non-default parameter '%s' follows default parameter", param.Value)
+		}
 		if !p.peekTokenIs(token.COMMA) {
 			break
 		}
@@ -453,10 +805,7 @@ func (p *Parser) parseFunctionParams() []*ast.Identifier {
 		p.nextToken() // 다음 식별자
 	}
 
-	if !p.expectPeek(token.RPAREN) {
-		return nil
-	}
-	return ids
+	p.expectPeek(token.RPAREN)
 }
 
 func (p *Parser) currentTokenIs(t token.Type) bool {
@@ -467,14 +816,20 @@ func (p *Parser) peekTokenIs(t token.Type) bool {
 	return p.peekToken.Type == t
 }
 
-func (p *Parser) expectPeek(t token.Type) bool {
+func (p *Parser) expectPeek(t token.Type) {
 	// 다음으로 오길 기대하는 토큰이 맞으면 해당 토큰을 소비하고 한단계 진행
-	if p.peekTokenIs(t) {
-		p.nextToken()
-		return true
+	if !p.peekTokenIs(t) {
+		p.markAsError(diagnostic.UnexpectedToken, p.peekToken, "expected: %s, but got: %s", t, p.peekToken.Type)
+	}
+	p.nextToken()
+}
+
+// spanFrom 메서드는 start부터 현재 토큰의 끝까지의 범위를 반환함
+func (p *Parser) spanFrom(start token.Position) ast.Span {
+	return ast.Span{
+		Start: start,
+		Stop:  p.currToken.End,
 	}
-	p.markAsError(t)
-	return false
 }
 
 func (p *Parser) currPrecedence() opPrecedence {
@@ -490,8 +845,3 @@ func (p *Parser) peekPrecedence() opPrecedence {
 	}
 	return LOWEST
 }
-
-// markAsError 메서드는 디버깅을 위해 파싱 과정에서 발생한 에러를 저장함
-func (p *Parser) markAsError(expected token.Type) {
-	p.Errs = multierror.Append(p.Errs, errors.Errorf("expected: %s, but got: %s", expected, p.peekToken.Type))
-}
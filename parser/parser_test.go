@@ -27,8 +27,203 @@ let x 5;
 		require.NotNil(t, program)
 		require.NotNil(t, p.Errs.ErrorOrNil())
 		require.Equal(t, `2 errors occurred:
-	* expected: IDENTIFIER, but got: INTEGER
-	* expected: =, but got: INTEGER`, strings.TrimSpace(p.Errs.Error()))
+	* 2:5: expected: IDENTIFIER, but got: INTEGER
+	* 3:7: expected: =, but got: INTEGER`, strings.TrimSpace(p.Errs.Error()))
+	})
+	t.Run("error recovery", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			name     string
+			input    string
+			errs     []string
+			expected string
+		}{
+			{
+				name:     "skip to next statement",
+				input:    "let x = ;\nlet y = 5; y",
+				errs:     []string{"1:9: no prefix parse function for ;"},
+				expected: "let y = 5;y",
+			},
+			{
+				name:     "skip to next statement keyword",
+				input:    "if (x { y } let z = 1;",
+				errs:     []string{"1:7: expected: ), but got: {"},
+				expected: "let z = 1;",
+			},
+			{
+				name:     "skip to next loop",
+				input:    "let x = ; while (x) { x }",
+				errs:     []string{"1:9: no prefix parse function for ;"},
+				expected: "while x x",
+			},
+			{
+				name:     "for without in",
+				input:    "for (x y) { x }; 1",
+				errs:     []string{"1:8: expected: IN, but got: IDENTIFIER"},
+				expected: "1",
+			},
+			{
+				name:     "break outside loop",
+				input:    "break; 1",
+				errs:     []string{"1:1: 'break' outside loop"},
+				expected: "1",
+			},
+			{
+				name:     "continue in function inside loop",
+				input:    "while (x) { let f = fn() { continue; }; break; }",
+				errs:     []string{"1:28: 'continue' outside loop"},
+				expected: "while x let f = fn() ;break;",
+			},
+			{
+				name:     "assign to slice",
+				input:    "a[1:] = 1; 2",
+				errs:     []string{"1:7: cannot assign to (a[1:])"},
+				expected: "2",
+			},
+			{
+				name:     "assign to non identifier",
+				input:    "a + b = 1; 2",
+				errs:     []string{"1:7: cannot assign to (a + b)"},
+				expected: "2",
+			},
+			{
+				name:     "positional after keyword argument",
+				input:    "f(x: 1, 2); 3",
+				errs:     []string{"1:9: positional argument follows keyword argument"},
+				expected: "3",
+			},
+			{
+				name:     "repeated keyword argument",
+				input:    "f(x: 1, x: 2); 3",
+				errs:     []string{"1:9: keyword argument repeated: 'x'"},
+				expected: "3",
+			},
+			{
+				name:     "default before required param",
+				input:    "fn(x = 1, y) {}; 2",
+				errs:     []string{"1:11: non-default parameter 'y' follows default parameter"},
+				expected: "2",
+			},
+			{
+				name:     "param after rest",
+				input:    "fn(...xs, y) {}; 2",
+				errs:     []string{"1:9: rest parameter must be last"},
+				expected: "2",
+			},
+			{
+				name:     "duplicate param",
+				input:    "fn(a, a) { a }(1, 2); 3",
+				errs:     []string{"1:7: duplicate parameter name: 'a'"},
+				expected: "3",
+			},
+			{
+				name:     "duplicate default param",
+				input:    "fn(a, b = 1, a = 2) {}; 3",
+				errs:     []string{"1:14: duplicate parameter name: 'a'"},
+				expected: "3",
+			},
+			{
+				name:     "rest param duplicating param",
+				input:    "fn(a, ...a) {}; 3",
+				errs:     []string{"1:10: duplicate parameter name: 'a'"},
+				expected: "3",
+			},
+			{
+				name:     "unclosed list",
+				input:    "let a = [1, 2; let b = 3;",
+				errs:     []string{"1:14: expected: ], but got: ;"},
+				expected: "let b = 3;",
+			},
+			{
+				name:     "failed prefix followed by infix operator",
+				input:    "fn(x, y + z) {}; 1",
+				errs:     []string{"1:9: expected: ), but got: +"},
+				expected: "1",
+			},
+			{
+				name:     "invalid parameter",
+				input:    "fn(1) {}; 2",
+				errs:     []string{"1:4: expected: IDENTIFIER, but got: INTEGER"},
+				expected: "2",
+			},
+			{
+				name:     "recover inside block",
+				input:    "let f = fn() { let a = ; a }; f",
+				errs:     []string{"1:24: no prefix parse function for ;"},
+				expected: "let f = fn() a;f",
+			},
+			{
+				name:     "recover at end of block",
+				input:    "let f = fn() { 1; let a = }; f",
+				errs:     []string{"1:27: no prefix parse function for }"},
+				expected: "let f = fn() 1;f",
+			},
+			{
+				name:     "nested braces in broken statement",
+				input:    "let h = {1: {2: 3} 4}; let i = 5;",
+				errs:     []string{"1:20: expected: ,, but got: INTEGER"},
+				expected: "let i = 5;",
+			},
+			{
+				name:     "stray closing brace",
+				input:    "}; 1",
+				errs:     []string{"1:1: no prefix parse function for }"},
+				expected: "1",
+			},
+			{
+				name:     "unterminated interpolation",
+				input:    `1; "a${1`,
+				errs:     []string{"1:4: unterminated string interpolation"},
+				expected: "1",
+			},
+			{
+				name:     "illegal token reported once",
+				input:    "let x = 1 + @ + 2; x",
+				errs:     []string{"1:13: illegal character '@'"},
+				expected: "x",
+			},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				p := New(lexer.New(tc.input))
+				program := p.ParseProgram()
+
+				errs := make([]string, 0, len(tc.errs))
+				for _, err := range p.Errs.WrappedErrors() {
+					errs = append(errs, err.Error())
+				}
+				require.Equal(t, tc.errs, errs)
+				for _, stmt := range program.Statements {
+					require.NotNil(t, stmt)
+				}
+				require.Equal(t, tc.expected, program.String())
+			})
+		}
+	})
+	t.Run("lexing errors", func(t *testing.T) {
+		t.Parallel()
+
+		input := `let x = "abc
+let y = @;`
+		p := New(lexer.New(input))
+		p.ParseProgram()
+		require.Equal(t, `2 errors occurred:
+	* 1:9: unterminated string literal
+	* 2:9: illegal character '@'`, strings.TrimSpace(p.Errs.Error()))
+	})
+	t.Run("errors in source order", func(t *testing.T) {
+		t.Parallel()
+
+		input := `let 5;
+let y = @;
+let = 1;`
+		p := New(lexer.New(input))
+		p.ParseProgram()
+		require.Equal(t, `3 errors occurred:
+	* 1:5: expected: IDENTIFIER, but got: INTEGER
+	* 2:9: illegal character '@'
+	* 3:5: expected: IDENTIFIER, but got: =`, strings.TrimSpace(p.Errs.Error()))
 	})
 	t.Run("let statements", func(t *testing.T) {
 		t.Parallel()
@@ -106,6 +301,86 @@ let x 5;
 
 		assertLiteralExpression(t, expStmt.Expression, 42)
 	})
+	t.Run("integer literals", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			input    string
+			expected int64
+		}{
+			{input: "1_000_000", expected: 1000000},
+			{input: "010", expected: 10},
+			{input: "0xff", expected: 255},
+			{input: "0XFF_FF", expected: 65535},
+			{input: "0o755", expected: 493},
+			{input: "0b1010_0101", expected: 165},
+		}
+		for _, tc := range cases {
+			t.Run(tc.input, func(t *testing.T) {
+				program := parseProgram(t, tc.input)
+				require.Len(t, program.Statements, 1)
+
+				stmt := program.Statements[0].(*ast.ExpressionStatement)
+				i, ok := stmt.Expression.(*ast.IntegerLiteral)
+				require.Truef(t, ok, "expected: *ast.IntegerLiteral, got: %T", stmt.Expression)
+				assert.Equal(t, tc.expected, i.Value)
+				// 원래 표기를 그대로 유지해야 함
+				assert.Equal(t, tc.input, i.String())
+			})
+		}
+
+		// int64 범위를 넘는 정수는 큰 정수 리터럴이 됨
+		for input, expected := range map[string]string{
+			"9223372036854775808":           "9223372036854775808",
+			"0009223372036854775808":        "9223372036854775808",
+			"0xffff_ffff_ffff_ffff":         "18446744073709551615",
+			"0b1" + strings.Repeat("0", 64): "18446744073709551616",
+		} {
+			program := parseProgram(t, input)
+			require.Len(t, program.Statements, 1)
+
+			stmt := program.Statements[0].(*ast.ExpressionStatement)
+			i, ok := stmt.Expression.(*ast.BigIntegerLiteral)
+			require.Truef(t, ok, "expected: *ast.BigIntegerLiteral, got: %T", stmt.Expression)
+			assert.Equal(t, expected, i.Value.String())
+			assert.Equal(t, input, i.String())
+		}
+
+		for _, input := range []string{"0b102", "0o8", "0x", "0b1_", "0b1" + strings.Repeat("0", 64) + "2"} {
+			p := New(lexer.New(input))
+			p.ParseProgram()
+			assert.Containsf(t, p.Errs.Error(), "could not parse", "input: %s", input)
+		}
+	})
+	t.Run("float expression", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			input    string
+			expected float64
+		}{
+			{input: "3.14", expected: 3.14},
+			{input: "1e-9", expected: 1e-9},
+			{input: "1_000.5", expected: 1000.5},
+		}
+		for _, tc := range cases {
+			program := parseProgram(t, tc.input)
+			require.Len(t, program.Statements, 1)
+
+			stmt := program.Statements[0].(*ast.ExpressionStatement)
+			f, ok := stmt.Expression.(*ast.FloatLiteral)
+			require.Truef(t, ok, "expected: *ast.FloatLiteral, got: %T", stmt.Expression)
+			assert.Equal(t, tc.expected, f.Value)
+			assert.Equal(t, tc.input, f.String())
+		}
+
+		p := New(lexer.New("1e+"))
+		p.ParseProgram()
+		require.EqualError(t, p.Errs.ErrorOrNil(), `1 error occurred:
+	* 1:1: could not parse "1e+" as float
+
+`)
+	})
 	t.Run("string expression", func(t *testing.T) {
 		t.Parallel()
 
@@ -120,6 +395,25 @@ let x 5;
 
 		assertStringLiteral(t, expStmt.Expression, "hello world")
 	})
+	t.Run("interpolated string", func(t *testing.T) {
+		t.Parallel()
+
+		input := `"hello ${name}, you are ${age + 1}"`
+
+		program := parseProgram(t, input)
+		require.Len(t, program.Statements, 1)
+
+		stmt := program.Statements[0].(*ast.ExpressionStatement)
+		s, ok := stmt.Expression.(*ast.InterpolatedString)
+		require.Truef(t, ok, "expected: *ast.InterpolatedString, got: %T", stmt.Expression)
+		require.Equal(t, []string{"hello ", ", you are ", ""}, s.Strings)
+		require.Len(t, s.Exprs, 2)
+		assertIdentifier(t, s.Exprs[0], "name")
+		assertInfixExpression(t, s.Exprs[1], "age", "+", 1)
+		assert.Equal(t, "hello ${name}, you are ${(age + 1)}", s.String())
+		assert.Equal(t, "1:1", s.Pos().String())
+		assert.Equal(t, "1:36", s.End().String())
+	})
 	t.Run("boolean expression", func(t *testing.T) {
 		t.Parallel()
 
@@ -258,6 +552,32 @@ let x 5;
 		assertIdentifier(t, index.Left, "myArray")
 		assertInfixExpression(t, index.Index, 1, "+", 1)
 	})
+	t.Run("slice expression", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			input    string
+			expected string
+		}{
+			{input: "a[1:2]", expected: "(a[1:2])"},
+			{input: "a[:2]", expected: "(a[:2])"},
+			{input: "a[1:]", expected: "(a[1:])"},
+			{input: "a[:]", expected: "(a[:])"},
+			{input: "a[1+1:-1]", expected: "(a[(1 + 1):(-1)])"},
+		}
+		for _, tc := range cases {
+			t.Run(tc.input, func(t *testing.T) {
+				program := parseProgram(t, tc.input)
+				require.Len(t, program.Statements, 1)
+
+				stmt := program.Statements[0].(*ast.ExpressionStatement)
+				slice, ok := stmt.Expression.(*ast.SliceExpression)
+				require.Truef(t, ok, "expected: *ast.SliceExpression, got: %T", stmt.Expression)
+				assertIdentifier(t, slice.Left, "a")
+				assert.Equal(t, tc.expected, slice.String())
+			})
+		}
+	})
 	t.Run("prefix expression", func(t *testing.T) {
 		t.Parallel()
 
@@ -367,6 +687,19 @@ let x 5;
 			{input: "a + add(b * c) + d", expected: "((a + add((b * c))) + d)"},
 			{input: "add(1, add(2, 3 * 4))", expected: "add(1, add(2, (3 * 4)))"},
 			{input: "1 * [2, 3][4 + 5] / 6", expected: "((1 * ([2, 3][(4 + 5)])) / 6)"},
+			{input: "a + b % c", expected: "(a + (b % c))"},
+			{input: "a % b * c", expected: "((a % b) * c)"},
+			{input: "a <= b == c >= d", expected: "((a <= b) == (c >= d))"},
+			{input: "a + 1 >= b * 2", expected: "((a + 1) >= (b * 2))"},
+			{input: "a = b + c", expected: "(a = (b + c))"},
+			{input: "a = b = c", expected: "(a = (b = c))"},
+			{input: "a += b || c", expected: "(a += (b || c))"},
+			{input: "f(a = 1)", expected: "f((a = 1))"},
+			{input: "a[b + 1] = c[d] = 1", expected: "((a[(b + 1)]) = ((c[d]) = 1))"},
+			{input: "a || b && c", expected: "(a || (b && c))"},
+			{input: "a && b || c", expected: "((a && b) || c)"},
+			{input: "a && b && c", expected: "((a && b) && c)"},
+			{input: "a < b && c == d || !e", expected: "(((a < b) && (c == d)) || (!e))"},
 		}
 		for _, tc := range cases {
 			t.Run(tc.input, func(t *testing.T) {
@@ -426,6 +759,61 @@ let x 5;
 		require.Truef(t, ok, "expected: *ast.ExpressionStatement, got: %T", ifExp.Alternative.Statements[0])
 		assertLiteralExpression(t, alternative.Expression, "y")
 	})
+	t.Run("while statement", func(t *testing.T) {
+		t.Parallel()
+
+		input := `while (x < y) { x; };`
+
+		program := parseProgram(t, input)
+		require.Len(t, program.Statements, 1)
+
+		stmt, ok := program.Statements[0].(*ast.WhileStatement)
+		require.Truef(t, ok, "expected: *ast.WhileStatement, got: %T", program.Statements[0])
+
+		assertInfixExpression(t, stmt.Condition, "x", "<", "y")
+		require.Len(t, stmt.Body.Statements, 1)
+		body, ok := stmt.Body.Statements[0].(*ast.ExpressionStatement)
+		require.Truef(t, ok, "expected: *ast.ExpressionStatement, got: %T", stmt.Body.Statements[0])
+		assertLiteralExpression(t, body.Expression, "x")
+	})
+	t.Run("for statement", func(t *testing.T) {
+		t.Parallel()
+
+		input := `for (x in [1, 2]) { print(x) }`
+
+		program := parseProgram(t, input)
+		require.Len(t, program.Statements, 1)
+
+		stmt, ok := program.Statements[0].(*ast.ForStatement)
+		require.Truef(t, ok, "expected: *ast.ForStatement, got: %T", program.Statements[0])
+
+		assertIdentifier(t, stmt.Variable, "x")
+		require.Equal(t, "[1, 2]", stmt.Iterable.String())
+		require.Len(t, stmt.Body.Statements, 1)
+		require.Equal(t, "for x in [1, 2] print(x)", stmt.String())
+	})
+	t.Run("break and continue", func(t *testing.T) {
+		t.Parallel()
+
+		input := `for (x in y) { if (x) { break; } continue }`
+
+		program := parseProgram(t, input)
+		require.Len(t, program.Statements, 1)
+
+		stmt, ok := program.Statements[0].(*ast.ForStatement)
+		require.Truef(t, ok, "expected: *ast.ForStatement, got: %T", program.Statements[0])
+		require.Len(t, stmt.Body.Statements, 2)
+
+		ifStmt, ok := stmt.Body.Statements[0].(*ast.ExpressionStatement)
+		require.Truef(t, ok, "expected: *ast.ExpressionStatement, got: %T", stmt.Body.Statements[0])
+		ifExp, ok := ifStmt.Expression.(*ast.IfExpression)
+		require.Truef(t, ok, "expected: *ast.IfExpression, got: %T", ifStmt.Expression)
+		_, ok = ifExp.Consequence.Statements[0].(*ast.BreakStatement)
+		require.Truef(t, ok, "expected: *ast.BreakStatement, got: %T", ifExp.Consequence.Statements[0])
+
+		_, ok = stmt.Body.Statements[1].(*ast.ContinueStatement)
+		require.Truef(t, ok, "expected: *ast.ContinueStatement, got: %T", stmt.Body.Statements[1])
+	})
 	t.Run("function literal", func(t *testing.T) {
 		t.Parallel()
 
@@ -449,6 +837,21 @@ let x 5;
 		bodyStmt := fn.Body.Statements[0].(*ast.ExpressionStatement)
 		require.Truef(t, ok, "expected: *ast.ExpressionStatement, got: %T", fn.Body.Statements[0])
 		assertInfixExpression(t, bodyStmt.Expression, "x", "+", "y")
+		assert.Empty(t, fn.Name)
+	})
+	t.Run("function name", func(t *testing.T) {
+		t.Parallel()
+
+		program := parseProgram(t, "let add = fn(x, y) { x + y }; let f = add; let g = [fn() {}]")
+		require.Len(t, program.Statements, 3)
+
+		fn, ok := program.Statements[0].(*ast.LetStatement).Value.(*ast.FunctionLiteral)
+		require.True(t, ok)
+		assert.Equal(t, "add", fn.Name)
+
+		// 함수 리터럴을 바로 선언하지 않으면 이름이 없음
+		array := program.Statements[2].(*ast.LetStatement).Value.(*ast.ArrayLiteral)
+		assert.Empty(t, array.Elements[0].(*ast.FunctionLiteral).Name)
 	})
 	t.Run("function params", func(t *testing.T) {
 		t.Parallel()
@@ -456,10 +859,15 @@ let x 5;
 		cases := []struct {
 			input    string
 			expected []string
+			defaults []string
+			rest     string
 		}{
 			{input: "fn() {}", expected: []string{}},
 			{input: "fn(x) {}", expected: []string{"x"}},
 			{input: "fn(x, y, z) {}", expected: []string{"x", "y", "z"}},
+			{input: "fn(x, y = 1, z = x + y) {}", expected: []string{"x", "y", "z"}, defaults: []string{"1", "(x + y)"}},
+			{input: "fn(...xs) {}", expected: []string{}, rest: "xs"},
+			{input: "fn(x, y = [], ...xs) {}", expected: []string{"x", "y"}, defaults: []string{"[]"}, rest: "xs"},
 		}
 
 		for _, tc := range cases {
@@ -472,6 +880,15 @@ let x 5;
 				for i, expected := range tc.expected {
 					assertLiteralExpression(t, fn.Params[i], expected)
 				}
+				require.Len(t, fn.Defaults, len(tc.defaults))
+				for i, expected := range tc.defaults {
+					assert.Equal(t, expected, fn.Defaults[i].String())
+				}
+				if tc.rest == "" {
+					assert.Nil(t, fn.Rest)
+				} else {
+					assertLiteralExpression(t, fn.Rest, tc.rest)
+				}
 			})
 		}
 	})
@@ -506,6 +923,8 @@ let x 5;
 			{input: "add()", expected: []string{}},
 			{input: "add(1)", expected: []string{"1"}},
 			{input: "add(1, 2*3, 4 + 5)", expected: []string{"1", "(2 * 3)", "(4 + 5)"}},
+			{input: "add(x: 1)", expected: []string{"x: 1"}},
+			{input: "add(1, y: a + b, z: [c])", expected: []string{"1", "y: (a + b)", "z: [c]"}},
 		}
 
 		for _, tc := range cases {
@@ -521,6 +940,49 @@ let x 5;
 			})
 		}
 	})
+	t.Run("node positions", func(t *testing.T) {
+		t.Parallel()
+
+		input := `let x = add(1, 2 * y);
+if (x) { [x] }`
+
+		program := parseProgram(t, input)
+		require.Len(t, program.Statements, 2)
+
+		cases := []struct {
+			node  ast.Node
+			start string
+			end   string
+		}{
+			{node: program, start: "1:1", end: "2:15"},
+			{node: program.Statements[0], start: "1:1", end: "1:23"},
+			{node: program.Statements[0].(*ast.LetStatement).Name, start: "1:5", end: "1:6"},
+			{node: program.Statements[0].(*ast.LetStatement).Value, start: "1:9", end: "1:22"},
+			{node: program.Statements[0].(*ast.LetStatement).Value.(*ast.CallExpression).Arguments[1], start: "1:16", end: "1:21"},
+			{node: program.Statements[1], start: "2:1", end: "2:15"},
+			{node: program.Statements[1].(*ast.ExpressionStatement).Expression.(*ast.IfExpression).Consequence, start: "2:8", end: "2:15"},
+		}
+		for _, tc := range cases {
+			assert.Equalf(t, tc.start, tc.node.Pos().String(), "start of %q", tc.node)
+			assert.Equalf(t, tc.end, tc.node.End().String(), "end of %q", tc.node)
+		}
+	})
+	t.Run("grouped expression positions", func(t *testing.T) {
+		t.Parallel()
+
+		program := parseProgram(t, "((1 + 2)) * f((x))")
+		require.Len(t, program.Statements, 1)
+
+		infix := program.Statements[0].(*ast.ExpressionStatement).Expression.(*ast.InfixExpression)
+		// 괄호까지 표현식의 범위에 포함됨
+		assert.Equal(t, "1:1", infix.Pos().String())
+		assert.Equal(t, "1:19", infix.End().String())
+		assert.Equal(t, "1:1", infix.Left.Pos().String())
+		assert.Equal(t, "1:10", infix.Left.End().String())
+		arg := infix.Right.(*ast.CallExpression).Arguments[0]
+		assert.Equal(t, "1:15", arg.Pos().String())
+		assert.Equal(t, "1:18", arg.End().String())
+	})
 }
 
 func parseProgram(t *testing.T, input string) *ast.Program {
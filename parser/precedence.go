@@ -9,20 +9,26 @@ func (p opPrecedence) String() string {
 	switch p {
 	case LOWEST:
 		return "LOWEST(1)"
+	case ASSIGN:
+		return "ASSIGN(2)"
+	case OR:
+		return "OR(3)"
+	case AND:
+		return "AND(4)"
 	case EQ:
-		return "EQ(2)"
+		return "EQ(5)"
 	case LTGT:
-		return "LTGT(3)"
+		return "LTGT(6)"
 	case SUM:
-		return "SUM(4)"
+		return "SUM(7)"
 	case PRODUCT:
-		return "PRODUCT(5)"
+		return "PRODUCT(8)"
 	case PREFIX:
-		return "PREFIX(6)"
+		return "PREFIX(9)"
 	case CALL:
-		return "CALL(7)"
+		return "CALL(10)"
 	case INDEX:
-		return "INDEX(8)"
+		return "INDEX(11)"
 	default:
 		return "UNKNOWN(0)"
 	}
@@ -30,10 +36,13 @@ func (p opPrecedence) String() string {
 
 const (
 	LOWEST  opPrecedence = iota + 1
+	ASSIGN               // x = y or x += y
+	OR                   // ||
+	AND                  // &&
 	EQ                   // ==
-	LTGT                 // < or >
+	LTGT                 // <, >, <= or >=
 	SUM                  // +
-	PRODUCT              // *
+	PRODUCT              // *, / or %
 	PREFIX               // -x or !x
 	CALL                 // x()
 	INDEX                // x[index]
@@ -41,15 +50,26 @@ const (
 
 var (
 	precedenceMap = map[token.Type]opPrecedence{
-		token.EQ:       EQ,
-		token.NEQ:      EQ,
-		token.LT:       LTGT,
-		token.GT:       LTGT,
-		token.PLUS:     SUM,
-		token.MINUS:    SUM,
-		token.ASTERISK: PRODUCT,
-		token.SLASH:    PRODUCT,
-		token.LPAREN:   CALL,
-		token.LBRACKET: INDEX,
+		token.ASSIGN:         ASSIGN,
+		token.PLUSASSIGN:     ASSIGN,
+		token.MINUSASSIGN:    ASSIGN,
+		token.ASTERISKASSIGN: ASSIGN,
+		token.SLASHASSIGN:    ASSIGN,
+		token.PERCENTASSIGN:  ASSIGN,
+		token.OR:             OR,
+		token.AND:            AND,
+		token.EQ:             EQ,
+		token.NEQ:            EQ,
+		token.LT:             LTGT,
+		token.GT:             LTGT,
+		token.LTE:            LTGT,
+		token.GTE:            LTGT,
+		token.PLUS:           SUM,
+		token.MINUS:          SUM,
+		token.ASTERISK:       PRODUCT,
+		token.SLASH:          PRODUCT,
+		token.PERCENT:        PRODUCT,
+		token.LPAREN:         CALL,
+		token.LBRACKET:       INDEX,
 	}
 )
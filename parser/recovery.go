@@ -0,0 +1,66 @@
+package parser
+
+import (
+	"github.com/hashicorp/go-multierror"
+
+	"go-interpreter/ast"
+	"go-interpreter/diagnostic"
+	"go-interpreter/token"
+)
+
+// bailout 은 에러가 발생한 명령문의 파싱을 중단하기 위해 사용하는 패닉 값
+// 에러가 발생한 뒤 일관되지 않은 상태로 토큰을 계속 소비하면
+// 실제 에러 하나에서 비롯된 잘못된 에러가 연달아 쌓이기 때문에 필요함
+type bailout struct{}
+
+// 에러 복구 시 다음 명령문의 시작으로 판단할 토큰
+var statementKeywords = map[token.Type]bool{
+	token.LET:      true,
+	token.RETURN:   true,
+	token.WHILE:    true,
+	token.FOR:      true,
+	token.BREAK:    true,
+	token.CONTINUE: true,
+}
+
+// markAsError 메서드는 파싱 과정에서 발생한 에러를 문제가 된 토큰의 범위와 함께 저장하고
+// 명령문 단위로 에러를 복구하기 위해 파싱을 중단함
+func (p *Parser) markAsError(code diagnostic.Code, tok token.Token, format string, args ...any) {
+	p.Errs = multierror.Append(p.Errs, diagnostic.Errorf(code, tok.Pos, tok.End, format, args...))
+	panic(bailout{})
+}
+
+// recoverStatement 메서드는 parseStatement에서 defer로 호출되어
+// markAsError로 중단된 명령문을 nil로 바꾸고 다음 명령문을 파싱할 수 있는 곳까지 진행함
+func (p *Parser) recoverStatement(depth int, stmt *ast.Statement) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if _, ok := r.(bailout); !ok {
+		panic(r)
+	}
+
+	*stmt = nil
+	p.synchronize(depth)
+}
+
+// synchronize 메서드는 명령문이 시작한 블록 안에서 다음 명령문 직전까지 토큰을 건너뜀
+// 호출한 곳에서 nextToken()을 호출하면 다음 명령문이나 블록을 닫는 } 가 currToken이 됨
+func (p *Parser) synchronize(depth int) {
+	for {
+		// 블록을 닫는 } 가 이미 currToken이라면 parseBlockStatement에서 처리함
+		if p.depth < depth || p.peekTokenIs(token.EOF) {
+			return
+		}
+		if p.depth == depth {
+			if p.currentTokenIs(token.SEMICOLON) {
+				return
+			}
+			if p.peekTokenIs(token.RBRACE) || statementKeywords[p.peekToken.Type] {
+				return
+			}
+		}
+		p.nextToken()
+	}
+}
@@ -0,0 +1,130 @@
+package repl
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"go-interpreter/ast"
+	"go-interpreter/diagnostic"
+	"go-interpreter/evaluator"
+	"go-interpreter/lexer"
+	"go-interpreter/object"
+	"go-interpreter/token"
+)
+
+// command 는 :env 처럼 콜론으로 시작하는 REPL 명령어
+type command struct {
+	name string
+	// 인자 이름, 인자가 필요 없다면 비워둠
+	arg  string
+	help string
+	run  func(s *session, arg string)
+}
+
+// :help 명령어가 commands를 참조하므로 초기화 순환을 피하기 위해 init에서 채움
+var commands []command
+
+func init() {
+	commands = []command{
+		{name: "help", help: "show this help", run: (*session).help},
+		{name: "env", help: "list bindings in the current environment", run: (*session).printEnv},
+		{name: "ast", arg: "<code>", help: "print the parsed tree", run: (*session).printAST},
+		{name: "tokens", arg: "<code>", help: "print the tokens", run: (*session).printTokens},
+		{name: "type", arg: "<expr>", help: "print the type of the evaluated value", run: (*session).printType},
+		{name: "reset", help: "clear all bindings", run: (*session).reset},
+		{name: "load", arg: "<file>", help: "evaluate the file in the current environment", run: (*session).load},
+		{name: "quit", help: "exit the REPL", run: func(s *session, _ string) { s.quit = true }},
+	}
+}
+
+// runCommand 메서드는 ":name arg" 형태로 입력한 명령어를 실행함
+func (s *session) runCommand(line string) {
+	name, arg, _ := strings.Cut(strings.TrimPrefix(line, ":"), " ")
+	arg = strings.TrimSpace(arg)
+	for _, c := range commands {
+		if c.name != name {
+			continue
+		}
+		if c.arg != "" && arg == "" {
+			_, _ = fmt.Fprintf(s.out, "usage: :%s %s\n", c.name, c.arg)
+			return
+		}
+		c.run(s, arg)
+		return
+	}
+	_, _ = fmt.Fprintf(s.out, "unknown command: :%s (type :help for a list of commands)\n", name)
+}
+
+func (s *session) help(string) {
+	for _, c := range commands {
+		usage := ":" + c.name
+		if c.arg != "" {
+			usage += " " + c.arg
+		}
+		_, _ = fmt.Fprintf(s.out, "%-15s %s\n", usage, c.help)
+	}
+}
+
+func (s *session) printEnv(string) {
+	for _, name := range s.env.Names() {
+		v, _ := s.env.Get(name)
+		// print() 처럼 값이 없는 결과에 바인딩된 이름은 :type 과 같이 null로 보여줌
+		if v == nil {
+			v = evaluator.Null
+		}
+		_, _ = fmt.Fprintf(s.out, "%s: %s = %s\n", name, v.Type(), v)
+	}
+}
+
+func (s *session) printAST(src string) {
+	if program := s.parse(src, ""); program != nil {
+		_ = ast.Fprint(s.out, program)
+	}
+}
+
+func (s *session) printTokens(src string) {
+	l := lexer.New(src)
+	l.KeepComments = true
+	for {
+		tok := l.NextToken()
+		_, _ = fmt.Fprintf(s.out, "%s-%s %s %q\n", tok.Pos, tok.End, tok.Type, tok.Literal)
+		if tok.Type == token.EOF {
+			break
+		}
+	}
+	for _, d := range diagnostic.From(l.Errs.ErrorOrNil()) {
+		_ = diagnostic.Render(s.out, d, src)
+	}
+}
+
+// printType 메서드는 표현식을 평가한 값의 타입을 출력함
+// 표현식 안에서 정의하거나 대입한 이름이 남지 않도록 현재 환경을 복사한 환경에서 평가함
+// 다만 값은 공유하므로 배열이나 해시를 그 자리에서 바꾸거나 이전에 정의한 함수가 바꾸는 이름은 그대로 반영됨
+func (s *session) printType(src string) {
+	program := s.parse(src, "")
+	if program == nil {
+		return
+	}
+	evaluated := evaluator.Eval(program, s.env.Copy())
+	if s.reportError(evaluated) {
+		return
+	}
+	if evaluated == nil {
+		evaluated = evaluator.Null
+	}
+	_, _ = fmt.Fprintf(s.out, "%s\n", evaluated.Type())
+}
+
+func (s *session) reset(string) {
+	s.env = object.NewEnvironment()
+}
+
+func (s *session) load(filename string) {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		_, _ = fmt.Fprintf(s.out, "failed to load: %s\n", err)
+		return
+	}
+	s.eval(string(src), filename)
+}
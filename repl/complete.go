@@ -0,0 +1,56 @@
+package repl
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"go-interpreter/evaluator"
+	"go-interpreter/token"
+)
+
+// complete 메서드는 커서 앞의 단어로 시작하는 예약어, 내장 함수, 현재 환경에 정의된 이름을 찾음
+// 줄 맨 앞에서 콜론으로 시작하는 단어는 REPL 명령어를 찾음
+// pos는 룬 단위의 커서 위치이며 반환값은 단어 앞부분, 완성 후보, 커서 뒷부분
+func (s *session) complete(line string, pos int) (string, []string, string) {
+	runes := []rune(line)
+	if pos > len(runes) {
+		pos = len(runes)
+	}
+	start := pos
+	for start > 0 && isWordChar(runes[start-1]) {
+		start--
+	}
+	head, word, tail := string(runes[:start]), string(runes[start:pos]), string(runes[pos:])
+
+	var candidates []string
+	switch {
+	case strings.TrimSpace(head) == ":":
+		head = strings.TrimSuffix(head, ":")
+		word = ":" + word
+		for _, c := range commands {
+			candidates = append(candidates, ":"+c.name)
+		}
+	case word == "":
+		return head, nil, tail
+	default:
+		candidates = append(candidates, token.Keywords()...)
+		candidates = append(candidates, evaluator.BuiltinNames()...)
+		candidates = append(candidates, s.env.Names()...)
+		sort.Strings(candidates)
+	}
+
+	var completions []string
+	for i, c := range candidates {
+		// 내장 함수와 같은 이름을 정의했을 수 있으므로 중복을 제거함
+		if !strings.HasPrefix(c, word) || (i > 0 && candidates[i-1] == c) {
+			continue
+		}
+		completions = append(completions, c)
+	}
+	return head, completions, tail
+}
+
+func isWordChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
@@ -0,0 +1,88 @@
+package repl
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/peterh/liner"
+	"github.com/pkg/errors"
+)
+
+// 사용자가 Ctrl-C를 눌러 입력을 취소했을 때 반환하는 에러
+var errAborted = liner.ErrPromptAborted
+
+// lineReader 는 프롬프트를 보여주고 한 줄을 입력받음
+type lineReader interface {
+	readLine(prompt string) (string, error)
+}
+
+// scannerReader 는 줄 편집 없이 입력을 그대로 읽으며 파이프 등 터미널이 아닌 입력에 사용함
+type scannerReader struct {
+	scanner *bufio.Scanner
+	out     io.Writer
+}
+
+func (r *scannerReader) readLine(prompt string) (string, error) {
+	_, _ = fmt.Fprint(r.out, prompt)
+	if !r.scanner.Scan() {
+		if err := r.scanner.Err(); err != nil {
+			return "", err
+		}
+		return "", io.EOF
+	}
+	return r.scanner.Text(), nil
+}
+
+// linerReader 는 방향키로 줄을 편집하고 Ctrl-R로 이전 입력을 찾을 수 있는 터미널 입력
+type linerReader struct {
+	state *liner.State
+}
+
+func (r *linerReader) readLine(prompt string) (string, error) {
+	line, err := r.state.Prompt(prompt)
+	if err != nil {
+		return "", err
+	}
+	if line != "" {
+		r.state.AppendHistory(line)
+	}
+	return line, nil
+}
+
+// StartTerminal 함수는 표준 입출력이 터미널일 때 줄 편집, 입력 기록, 탭 자동 완성을 지원하는 REPL을 시작함
+// historyFile이 주어지면 시작할 때 입력 기록을 불러오고 종료할 때 저장함
+func StartTerminal(historyFile string) error {
+	state := liner.NewLiner()
+	defer func() {
+		_ = state.Close()
+	}()
+	state.SetCtrlCAborts(true)
+
+	s := newSession(os.Stdout)
+	state.SetWordCompleter(s.complete)
+	if historyFile != "" {
+		if f, err := os.Open(historyFile); err == nil {
+			_, _ = state.ReadHistory(f)
+			_ = f.Close()
+		}
+	}
+
+	run(&linerReader{state: state}, s)
+
+	if historyFile == "" {
+		return nil
+	}
+	f, err := os.Create(historyFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to save history")
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	if _, err := state.WriteHistory(f); err != nil {
+		return errors.Wrap(err, "failed to save history")
+	}
+	return nil
+}
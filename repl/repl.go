@@ -6,39 +6,177 @@ import (
 	"io"
 	"strings"
 
+	"github.com/pkg/errors"
+
+	"go-interpreter/ast"
+	"go-interpreter/diagnostic"
 	"go-interpreter/evaluator"
 	"go-interpreter/lexer"
 	"go-interpreter/object"
 	"go-interpreter/parser"
+	"go-interpreter/token"
 )
 
-const PROMPT = ">>> "
+const (
+	PROMPT = ">>> "
+	// 입력이 아직 끝나지 않았을 때 보여주는 프롬프트
+	CONTINUATION = "... "
+)
 
+// Start 함수는 in에서 한 줄씩 입력받아 평가한 결과를 out에 출력함
 func Start(in io.Reader, out io.Writer) {
-	scanner := bufio.NewScanner(in)
-	env := object.NewEnvironment()
+	run(&scannerReader{scanner: bufio.NewScanner(in), out: out}, newSession(out))
+}
 
-	for {
-		// TODO: 멀티라인 입력 지원
-		_, _ = fmt.Fprint(out, PROMPT)
-		if !scanner.Scan() {
+func run(r lineReader, s *session) {
+	var lines []string
+	for !s.quit {
+		prompt := PROMPT
+		if len(lines) > 0 {
+			prompt = CONTINUATION
+		}
+		line, err := r.readLine(prompt)
+		if errors.Is(err, errAborted) {
+			// 입력 중이던 내용을 버리고 새로 입력받음
+			lines = nil
+			continue
+		}
+		if err != nil {
 			return
 		}
 
-		line := scanner.Text()
-		l := lexer.New(line)
-		p := parser.New(l)
-
-		program := p.ParseProgram()
-		if p.Errs.ErrorOrNil() != nil {
-			_, _ = fmt.Fprintf(out, "%s\n", strings.TrimSpace(p.Errs.Error()))
+		if len(lines) == 0 && strings.HasPrefix(strings.TrimSpace(line), ":") {
+			s.runCommand(strings.TrimSpace(line))
 			continue
 		}
+		// 이어지는 입력 중 빈 줄을 입력하면 완성되지 않았더라도 그대로 실행함
+		if len(lines) == 0 || line != "" {
+			lines = append(lines, line)
+		}
+		src := strings.Join(lines, "\n")
+		if line != "" && isIncomplete(src) {
+			continue
+		}
+		lines = nil
+
+		if evaluated := s.eval(src, ""); evaluated != nil {
+			_, _ = fmt.Fprintf(s.out, "%s\n", evaluated)
+		}
+	}
+}
 
-		// TODO: 현재 환경을 디버깅 할 수 있는 구문 추가
-		evaluated := evaluator.Eval(program, env)
-		if evaluated != nil {
-			_, _ = fmt.Fprintf(out, "%s\n", evaluated)
+// session 은 REPL을 종료할 때까지 유지되는 상태
+type session struct {
+	out io.Writer
+	env *object.Environment
+	// 이전에 입력한 함수에서 발생한 에러도 해당 입력을 보여줄 수 있도록 입력마다 이름을 붙여 보관함
+	sources map[string]string
+	quit    bool
+}
+
+func newSession(out io.Writer) *session {
+	return &session{
+		out:     out,
+		env:     object.NewEnvironment(),
+		sources: map[string]string{},
+		quit:    false,
+	}
+}
+
+// parse 메서드는 소스 코드를 파싱하며 에러가 있으면 출력하고 nil을 반환함
+// 파일 이름이 주어지지 않으면 입력 순서대로 이름을 붙임
+func (s *session) parse(src, filename string) *ast.Program {
+	if filename == "" {
+		filename = fmt.Sprintf("<stdin:%d>", len(s.sources)+1)
+	}
+	s.sources[filename] = src
+	p := parser.New(lexer.NewReader(strings.NewReader(src), filename))
+
+	program := p.ParseProgram()
+	if err := p.Errs.ErrorOrNil(); err != nil {
+		for _, d := range diagnostic.From(err) {
+			_ = diagnostic.Render(s.out, d, src)
+		}
+		return nil
+	}
+	return program
+}
+
+// eval 메서드는 소스 코드를 현재 환경에서 평가하며 에러가 발생하면 출력하고 nil을 반환함
+func (s *session) eval(src, filename string) object.Object {
+	program := s.parse(src, filename)
+	if program == nil {
+		return nil
+	}
+	evaluated := evaluator.Eval(program, s.env)
+	if s.reportError(evaluated) {
+		return nil
+	}
+	return evaluated
+}
+
+// reportError 메서드는 평가 결과가 에러라면 에러가 발생한 소스 코드와 함께 출력함
+func (s *session) reportError(evaluated object.Object) bool {
+	err, ok := evaluated.(*object.Error)
+	if !ok {
+		return false
+	}
+	d := err.Diagnostic()
+	_ = diagnostic.Render(s.out, d, s.sources[d.Span.Start.Filename])
+	return true
+}
+
+// 이 토큰으로 끝나는 입력은 피연산자가 뒤따라야 하므로 완성되지 않은 것으로 봄
+var trailingOperators = map[token.Type]bool{
+	token.ASSIGN:         true,
+	token.PLUSASSIGN:     true,
+	token.MINUSASSIGN:    true,
+	token.ASTERISKASSIGN: true,
+	token.SLASHASSIGN:    true,
+	token.PERCENTASSIGN:  true,
+	token.PLUS:           true,
+	token.MINUS:          true,
+	token.BANG:           true,
+	token.ASTERISK:       true,
+	token.SLASH:          true,
+	token.PERCENT:        true,
+	token.EQ:             true,
+	token.NEQ:            true,
+	token.LT:             true,
+	token.GT:             true,
+	token.LTE:            true,
+	token.GTE:            true,
+	token.AND:            true,
+	token.OR:             true,
+	token.COMMA:          true,
+	token.COLON:          true,
+}
+
+// isIncomplete 함수는 괄호가 닫히지 않았거나, 원시 문자열이나 블록 주석이 끝나지 않았거나,
+// 연산자로 끝나서 다음 줄을 더 입력받아야 하는지 판단함
+func isIncomplete(src string) bool {
+	l := lexer.New(src)
+	depth := 0
+	last := token.Type(token.EOF)
+	for {
+		tok := l.NextToken()
+		if tok.Type == token.EOF {
+			break
+		}
+		switch tok.Type {
+		case token.LPAREN, token.LBRACKET, token.LBRACE, token.INTERPHEAD:
+			depth++
+		case token.RPAREN, token.RBRACKET, token.RBRACE, token.INTERPTAIL:
+			depth--
+		case token.ILLEGAL:
+			// 원시 문자열과 블록 주석은 여러 줄에 걸칠 수 있으므로 입력의 끝까지 닫히지 않았다면 이어 입력받음
+			// 일반 문자열은 줄을 넘을 수 없으므로 이어 입력해도 고칠 수 없음
+			rest := src[tok.Pos.Offset:]
+			if tok.End.Offset == len(src) && (strings.HasPrefix(rest, "`") || strings.HasPrefix(rest, "/*")) {
+				return true
+			}
 		}
+		last = tok.Type
 	}
+	return depth > 0 || trailingOperators[last]
 }
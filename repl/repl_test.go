@@ -0,0 +1,195 @@
+package repl
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsIncomplete(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    string
+		expected bool
+	}{
+		{input: "let x = 1;", expected: false},
+		{input: "", expected: false},
+		{input: "let add = fn(x, y) {", expected: true},
+		{input: "let add = fn(x, y) {\n  x + y\n}", expected: false},
+		{input: "add(1,", expected: true},
+		{input: "[1, 2", expected: true},
+		{input: `{"a":`, expected: true},
+		{input: "1 +", expected: true},
+		{input: "x &&", expected: true},
+		{input: "let x =", expected: true},
+		{input: `"a ${x`, expected: true},
+		{input: `"a ${x} b"`, expected: false},
+		{input: "`raw\nstring", expected: true},
+		{input: "`raw\nstring`", expected: false},
+		{input: "1 /* comment", expected: true},
+		{input: "1 // comment {", expected: false},
+		// 일반 문자열은 줄을 넘을 수 없으므로 바로 에러를 보여줌
+		{input: `"abc`, expected: false},
+		// 닫는 괄호가 더 많은 입력은 이어 입력해도 고칠 수 없음
+		{input: "1)", expected: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isIncomplete(tc.input))
+		})
+	}
+}
+
+func TestStart(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "single line",
+			input:    "1 + 2\n",
+			expected: ">>> 3\n>>> ",
+		},
+		{
+			name:     "multiline function",
+			input:    "let add = fn(x, y) {\n  x + y\n}\nadd(1, 2)\n",
+			expected: ">>> ... ... >>> 3\n>>> ",
+		},
+		{
+			name:     "empty line submits incomplete input",
+			input:    "(1\n\n1\n",
+			expected: ">>> ... error[unexpected-token]: expected: ), but got: EOF\n --> <stdin:1>:1:3\n  |\n1 | (1\n  |   ^\n>>> 1\n>>> ",
+		},
+		{
+			name:     "runtime error points into earlier input",
+			input:    "let f = fn() {\n  -true\n}\nf()\n",
+			expected: ">>> ... ... >>> error[runtime-error]: unsupported operator: -'bool'\n --> <stdin:1>:2:3\n  |\n2 |   -true\n  |   ^^^^^\n>>> ",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			Start(strings.NewReader(tc.input), &out)
+			assert.Equal(t, tc.expected, out.String())
+		})
+	}
+}
+
+func TestCommands(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "lib.monkey")
+	require.NoError(t, os.WriteFile(file, []byte("let double = fn(x) { x * 2 };"), 0o600))
+
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "env",
+			input:    "let b = 1; let a = \"x\"\n:env\n",
+			expected: ">>> >>> a: string = x\nb: int = 1\n>>> ",
+		},
+		{
+			name:     "env with missing value",
+			input:    "let q = print()\n:env\n",
+			expected: ">>> >>> q: null = null\n>>> ",
+		},
+		{
+			name:     "ast",
+			input:    ":ast -x\n",
+			expected: ">>> *ast.Program 1:1-1:3\n  Statements[0]: *ast.ExpressionStatement 1:1-1:3\n    Expression: *ast.PrefixExpression 1:1-1:3 Operator=\"-\"\n      Right: *ast.Identifier 1:2-1:3 Value=\"x\"\n>>> ",
+		},
+		{
+			name:     "tokens",
+			input:    ":tokens x + 1\n",
+			expected: ">>> 1:1-1:2 IDENTIFIER \"x\"\n1:3-1:4 + \"+\"\n1:5-1:6 INTEGER \"1\"\n1:6-1:6 EOF \"\"\n>>> ",
+		},
+		{
+			name:     "type",
+			input:    ":type 1.5\n:type let x = 1\n:env\n",
+			expected: ">>> float\n>>> null\n>>> >>> ",
+		},
+		{
+			name:     "type keeps assigned names",
+			input:    "let x = 1\n:type x = \"s\"\nx\n",
+			expected: ">>> >>> string\n>>> 1\n>>> ",
+		},
+		{
+			name:     "reset",
+			input:    "let x = 1\n:reset\n:env\n",
+			expected: ">>> >>> >>> >>> ",
+		},
+		{
+			name:     "load",
+			input:    ":load " + file + "\ndouble(21)\n",
+			expected: ">>> >>> 42\n>>> ",
+		},
+		{
+			name:     "quit",
+			input:    ":quit\n1\n",
+			expected: ">>> ",
+		},
+		{
+			name:     "missing argument",
+			input:    ":ast\n",
+			expected: ">>> usage: :ast <code>\n>>> ",
+		},
+		{
+			name:     "unknown command",
+			input:    ":foo\n",
+			expected: ">>> unknown command: :foo (type :help for a list of commands)\n>>> ",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			Start(strings.NewReader(tc.input), &out)
+			assert.Equal(t, tc.expected, out.String())
+		})
+	}
+}
+
+func TestComplete(t *testing.T) {
+	t.Parallel()
+
+	s := newSession(&bytes.Buffer{})
+	s.eval("let length = 1; let lesson = 2; let len = 3;", "")
+
+	cases := []struct {
+		line        string
+		pos         int
+		head        string
+		completions []string
+		tail        string
+	}{
+		// 내장 함수와 같은 이름은 한 번만 보여줌
+		{line: "le", pos: 2, head: "", completions: []string{"len", "length", "lesson", "let"}, tail: ""},
+		{line: "1 + leng", pos: 8, head: "1 + ", completions: []string{"length"}, tail: ""},
+		{line: "f(pr, 1)", pos: 4, head: "f(", completions: []string{"print"}, tail: ", 1)"},
+		{line: "fa", pos: 2, head: "", completions: []string{"false"}, tail: ""},
+		{line: "1 + ", pos: 4, head: "1 + ", completions: nil, tail: ""},
+		{line: "xyz", pos: 3, head: "", completions: nil, tail: ""},
+		{line: ":re", pos: 3, head: "", completions: []string{":reset"}, tail: ""},
+		{line: "한글", pos: 1, head: "", completions: nil, tail: "글"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.line, func(t *testing.T) {
+			head, completions, tail := s.complete(tc.line, tc.pos)
+			assert.Equal(t, tc.head, head)
+			assert.Equal(t, tc.completions, completions)
+			assert.Equal(t, tc.tail, tail)
+		})
+	}
+}
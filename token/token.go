@@ -1,20 +1,65 @@
 package token
 
+import (
+	"fmt"
+	"sort"
+)
+
 type Type string
 
 type Token struct {
 	Type    Type
 	Literal string
+	// 토큰이 시작하는 위치
+	Pos Position
+	// 토큰의 마지막 문자 바로 다음 위치
+	End Position
+}
+
+// Position 은 소스 코드 상의 위치를 나타냄
+type Position struct {
+	Filename string `json:"filename,omitempty"`
+	Offset   int    `json:"offset"` // 0부터 시작하는 바이트 오프셋
+	Line     int    `json:"line"`   // 1부터 시작하는 행 번호
+	Column   int    `json:"column"` // 1부터 시작하는 열 번호
+}
+
+// IsValid 메서드는 렉서가 채운 위치인지 판단함
+func (p Position) IsValid() bool {
+	return p.Line > 0
+}
+
+// String 메서드는 "file:line:column" 형태로 위치를 반환하며
+// 파일 이름이 없으면 "line:column" 형태로 반환함
+func (p Position) String() string {
+	if !p.IsValid() {
+		if p.Filename != "" {
+			return p.Filename
+		}
+		return "-"
+	}
+	s := fmt.Sprintf("%d:%d", p.Line, p.Column)
+	if p.Filename != "" {
+		s = p.Filename + ":" + s
+	}
+	return s
 }
 
 const (
 	ILLEGAL = "ILLEGAL" // 알 수 없는 토큰
 	EOF     = "EOF"     // 파일의 끝
+	COMMENT = "COMMENT" // 주석, 렉서가 주석을 남기도록 설정했을 때만 반환됨
 
 	// 식별자 + 리터럴
 	IDENTIFIER = "IDENTIFIER" // 변수 이름
 	INTEGER    = "INTEGER"
+	FLOAT      = "FLOAT"
 	STRING     = "STRING"
+	// "a ${x} b ${y} c" 같은 보간 문자열은 표현식 사이의 문자열 조각을
+	// `"a ${`, `} b ${`, `} c"` 세 종류의 토큰으로 나눠 반환함
+	INTERPHEAD   = "INTERPHEAD"
+	INTERPMIDDLE = "INTERPMIDDLE"
+	INTERPTAIL   = "INTERPTAIL"
 
 	// 연산자
 	ASSIGN   = "="
@@ -23,16 +68,30 @@ const (
 	BANG     = "!"
 	ASTERISK = "*"
 	SLASH    = "/"
+	PERCENT  = "%"
+
+	// 복합 대입 연산자
+	PLUSASSIGN     = "+="
+	MINUSASSIGN    = "-="
+	ASTERISKASSIGN = "*="
+	SLASHASSIGN    = "/="
+	PERCENTASSIGN  = "%="
 
 	EQ  = "=="
 	NEQ = "!="
 	LT  = "<"
 	GT  = ">"
+	LTE = "<="
+	GTE = ">="
+
+	AND = "&&"
+	OR  = "||"
 
 	// 구분자
 	COMMA     = ","
 	SEMICOLON = ";"
 	COLON     = ":"
+	ELLIPSIS  = "..."
 
 	LPAREN   = "("
 	RPAREN   = ")"
@@ -49,16 +108,26 @@ const (
 	IF       = "IF"
 	ELSE     = "ELSE"
 	RETURN   = "RETURN"
+	WHILE    = "WHILE"
+	FOR      = "FOR"
+	IN       = "IN"
+	BREAK    = "BREAK"
+	CONTINUE = "CONTINUE"
 )
 
 var keywords = map[string]Type{
-	"fn":     FUNCTION,
-	"let":    LET,
-	"true":   TRUE,
-	"false":  FALSE,
-	"if":     IF,
-	"else":   ELSE,
-	"return": RETURN,
+	"fn":       FUNCTION,
+	"let":      LET,
+	"true":     TRUE,
+	"false":    FALSE,
+	"if":       IF,
+	"else":     ELSE,
+	"return":   RETURN,
+	"while":    WHILE,
+	"for":      FOR,
+	"in":       IN,
+	"break":    BREAK,
+	"continue": CONTINUE,
 }
 
 // 주어진 식별자가 예약어인지 아닌지 판단
@@ -68,3 +137,13 @@ func LookupIdentifier(s string) Type {
 	}
 	return IDENTIFIER
 }
+
+// Keywords 함수는 모든 예약어를 정렬해 반환함
+func Keywords() []string {
+	words := make([]string, 0, len(keywords))
+	for word := range keywords {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+	return words
+}